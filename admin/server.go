@@ -0,0 +1,124 @@
+// Package admin implements the LoggingAdmin gRPC service, controlling logging of grpc-zerolog interceptors at runtime.
+package admin
+
+import (
+	"context"
+	"sort"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer of LoggingAdmin calls to a gRPC method (e.g. "/grpczerolog.admin.LoggingAdmin/SetLevel"). Returning an
+// error (e.g. codes.PermissionDenied) rejects the call.
+type Authorizer func(ctx context.Context, method string) error
+
+// Server of LoggingAdmin, changing the global Zerolog level and MethodPolicy of interceptors.
+type Server struct {
+	UnimplementedLoggingAdminServer
+	authorize Authorizer
+}
+
+// NewServer of LoggingAdmin, authorizing every call with authorize. A nil Authorizer denies every call.
+func NewServer(authorize Authorizer) *Server {
+	return &Server{authorize: authorize}
+}
+
+// Register a LoggingAdmin Server to a gRPC server, authorizing every call with authorize.
+func Register(s *grpc.Server, authorize Authorizer) {
+	RegisterLoggingAdminServer(s, NewServer(authorize))
+}
+
+func (s *Server) check(ctx context.Context) error {
+	if s.authorize == nil {
+		return status.Error(codes.PermissionDenied, "no authorizer")
+	}
+	method, _ := grpc.Method(ctx)
+	return s.authorize(ctx, method)
+}
+
+// GetLevel of the global Zerolog level.
+func (s *Server) GetLevel(ctx context.Context, _ *GetLevelRequest) (*Level, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	return &Level{Level: zerolog.GlobalLevel().String()}, nil
+}
+
+// SetLevel of the global Zerolog level.
+func (s *Server) SetLevel(ctx context.Context, req *Level) (*Level, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	level, err := zerolog.ParseLevel(req.GetLevel())
+	if err != nil || req.GetLevel() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid level %q", req.GetLevel())
+	}
+	zerolog.SetGlobalLevel(level)
+	return &Level{Level: level.String()}, nil
+}
+
+// GetMethodPolicy applied to a gRPC method.
+func (s *Server) GetMethodPolicy(ctx context.Context, req *GetMethodPolicyRequest) (*MethodPolicy, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	return toMethodPolicy(req.GetMethod(), grpczerolog.GetMethodPolicy(req.GetMethod())), nil
+}
+
+// ListMethodPolicies set, sorted by method.
+func (s *Server) ListMethodPolicies(ctx context.Context, _ *ListMethodPoliciesRequest) (*ListMethodPoliciesResponse, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	resp := &ListMethodPoliciesResponse{}
+	for method, policy := range grpczerolog.GetMethodPolicies() {
+		resp.Policies = append(resp.Policies, toMethodPolicy(method, policy))
+	}
+	sort.Slice(resp.Policies, func(i, j int) bool {
+		return resp.Policies[i].Method < resp.Policies[j].Method
+	})
+	return resp, nil
+}
+
+// SetMethodPolicy of a gRPC method, service or the default policy.
+func (s *Server) SetMethodPolicy(ctx context.Context, req *MethodPolicy) (*MethodPolicy, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetMethod() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty method")
+	}
+	if rate := req.GetSampleRate(); rate < 0 || rate > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sample rate %v", rate)
+	}
+	grpczerolog.SetMethodPolicy(req.GetMethod(), &grpczerolog.MethodPolicy{
+		Disabled:   req.GetDisabled(),
+		SampleRate: req.GetSampleRate(),
+		OmitReq:    req.GetOmitReq(),
+		OmitResp:   req.GetOmitResp(),
+	})
+	return req, nil
+}
+
+// DeleteMethodPolicy of a gRPC method, service or the default policy.
+func (s *Server) DeleteMethodPolicy(ctx context.Context, req *DeleteMethodPolicyRequest) (*DeleteMethodPolicyResponse, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	grpczerolog.SetMethodPolicy(req.GetMethod(), nil)
+	return &DeleteMethodPolicyResponse{}, nil
+}
+
+func toMethodPolicy(method string, policy grpczerolog.MethodPolicy) *MethodPolicy {
+	return &MethodPolicy{
+		Method:     method,
+		Disabled:   policy.Disabled,
+		SampleRate: policy.SampleRate,
+		OmitReq:    policy.OmitReq,
+		OmitResp:   policy.OmitResp,
+	}
+}
@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const token = "PhilipWasHere"
+
+type ServerSuite struct {
+	suite.Suite
+	server  *grpc.Server
+	conn    *grpc.ClientConn
+	client  LoggingAdminClient
+	ctx     context.Context
+	methods []string
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
+
+func (s *ServerSuite) SetupTest() {
+	lis := bufconn.Listen(1024 * 1024)
+	s.methods = nil
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	s.server = grpc.NewServer()
+	Register(s.server, func(ctx context.Context, method string) error {
+		s.methods = append(s.methods, method)
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-admin-token")) == 0 || md.Get("x-admin-token")[0] != token {
+			return status.Error(codes.PermissionDenied, "invalid admin token")
+		}
+		return nil
+	})
+	go s.server.Serve(lis)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+	s.client = NewLoggingAdminClient(conn)
+	s.ctx = metadata.AppendToOutgoingContext(context.Background(), "x-admin-token", token)
+}
+
+func (s *ServerSuite) TearDownTest() {
+	s.conn.Close()
+	s.server.Stop()
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	for method := range grpczerolog.GetMethodPolicies() {
+		grpczerolog.SetMethodPolicy(method, nil)
+	}
+}
+
+func (s *ServerSuite) TestUnauthorized() {
+	_, err := s.client.SetLevel(context.Background(), &Level{Level: "error"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+	s.Equal([]string{"/grpczerolog.admin.LoggingAdmin/SetLevel"}, s.methods)
+	s.Equal(zerolog.DebugLevel, zerolog.GlobalLevel())
+}
+
+func (s *ServerSuite) TestLevel() {
+	level, err := s.client.SetLevel(s.ctx, &Level{Level: "warn"})
+	s.Require().NoError(err)
+	s.Equal("warn", level.Level)
+	s.Equal(zerolog.WarnLevel, zerolog.GlobalLevel())
+
+	level, err = s.client.GetLevel(s.ctx, &GetLevelRequest{})
+	s.Require().NoError(err)
+	s.Equal("warn", level.Level)
+
+	_, err = s.client.SetLevel(s.ctx, &Level{Level: "loud"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.SetLevel(s.ctx, &Level{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServerSuite) TestMethodPolicy() {
+	_, err := s.client.SetMethodPolicy(s.ctx, &MethodPolicy{Method: "*", OmitReq: true})
+	s.Require().NoError(err)
+	_, err = s.client.SetMethodPolicy(s.ctx, &MethodPolicy{Method: "/example.ExampleService/ExampleMethod", Disabled: true})
+	s.Require().NoError(err)
+	s.True(grpczerolog.GetMethodPolicy("/example.ExampleService/ExampleMethod").Disabled)
+
+	policy, err := s.client.GetMethodPolicy(s.ctx, &GetMethodPolicyRequest{Method: "/example.ExampleService/OtherMethod"})
+	s.Require().NoError(err)
+	s.True(policy.OmitReq)
+
+	policies, err := s.client.ListMethodPolicies(s.ctx, &ListMethodPoliciesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(policies.Policies, 2)
+	s.Equal("*", policies.Policies[0].Method)
+	s.Equal("/example.ExampleService/ExampleMethod", policies.Policies[1].Method)
+
+	_, err = s.client.DeleteMethodPolicy(s.ctx, &DeleteMethodPolicyRequest{Method: "*"})
+	s.Require().NoError(err)
+	s.False(grpczerolog.GetMethodPolicy("/example.ExampleService/OtherMethod").OmitReq)
+}
+
+func (s *ServerSuite) TestMethodPolicyInvalid() {
+	_, err := s.client.SetMethodPolicy(s.ctx, &MethodPolicy{SampleRate: 0.5})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.SetMethodPolicy(s.ctx, &MethodPolicy{Method: "*", SampleRate: 2})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.Empty(grpczerolog.GetMethodPolicies())
+}
+
+func (s *ServerSuite) TestNilAuthorizer() {
+	_, err := NewServer(nil).SetLevel(context.Background(), &Level{Level: "trace"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+	s.Equal(zerolog.DebugLevel, zerolog.GlobalLevel(), "Expected calls denied without an authorizer")
+}
@@ -0,0 +1,118 @@
+package admin
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TailBuffer of call events per TailLogs client, before events are dropped.
+var TailBuffer = 256
+
+// TailLogs of calls ended in the interceptors, matching the request filters, until the client cancels.
+func (s *Server) TailLogs(req *TailLogsRequest, stream LoggingAdmin_TailLogsServer) error {
+	if err := s.check(stream.Context()); err != nil {
+		return err
+	}
+	filter, err := newTailFilter(req)
+	if err != nil {
+		return err
+	}
+	events, cancel := grpczerolog.SubscribeCalls(TailBuffer)
+	defer cancel()
+	var dropped uint64
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			dropped += event.Dropped
+			if !filter.match(event) {
+				continue
+			}
+			if err := stream.Send(toLogEvent(event, dropped)); err != nil {
+				return err
+			}
+			dropped = 0
+		}
+	}
+}
+
+type tailFilter struct {
+	methods     []string
+	codes       map[string]bool
+	minDuration time.Duration
+	peers       []*net.IPNet
+}
+
+func newTailFilter(req *TailLogsRequest) (*tailFilter, error) {
+	f := &tailFilter{
+		methods:     req.GetMethods(),
+		codes:       map[string]bool{},
+		minDuration: req.GetMinDuration().AsDuration(),
+	}
+	for _, code := range req.GetCodes() {
+		f.codes[code] = true
+	}
+	for _, p := range req.GetPeers() {
+		ipNet, err := grpczerolog.ParseIPNet(p)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid peer %q", p)
+		}
+		f.peers = append(f.peers, ipNet)
+	}
+	return f, nil
+}
+
+func (f *tailFilter) match(event grpczerolog.CallEvent) bool {
+	if len(f.codes) > 0 && !f.codes[event.Code] {
+		return false
+	}
+	if event.Duration < f.minDuration {
+		return false
+	}
+	return f.matchMethod(event.FullMethod) && f.matchPeer(event.Peer)
+}
+
+func (f *tailFilter) matchMethod(method string) bool {
+	if len(f.methods) == 0 {
+		return true
+	}
+	for _, m := range f.methods {
+		if m == method || (strings.HasSuffix(m, "/*") && strings.HasPrefix(method, m[:len(m)-1])) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *tailFilter) matchPeer(peer string) bool {
+	if len(f.peers) == 0 {
+		return true
+	}
+	ip := net.ParseIP(peer)
+	for _, ipNet := range f.peers {
+		if ip != nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func toLogEvent(event grpczerolog.CallEvent, dropped uint64) *LogEvent {
+	return &LogEvent{
+		Time:     timestamppb.New(event.Time),
+		Method:   event.FullMethod,
+		Code:     event.Code,
+		Duration: durationpb.New(event.Duration),
+		Peer:     event.Peer,
+		Log:      event.Log,
+		Dropped:  dropped,
+	}
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// publish calls until done, as TailLogs subscribes asynchronously.
+func (s *ServerSuite) publish(done chan struct{}, calls ...func()) {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		for _, call := range calls {
+			call()
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func call(method, ip string, d time.Duration, err error) func() {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 8000}})
+	return func() {
+		grpczerolog.PublishCall(ctx, method, time.Now().Add(-d), err, func(e *zerolog.Event) {
+			e.Str("method", method).Msg("unary")
+		})
+	}
+}
+
+func (s *ServerSuite) TestTailLogs() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	stream, err := s.client.TailLogs(ctx, &TailLogsRequest{
+		Methods:     []string{"/example.ExampleService/*"},
+		Codes:       []string{"NotFound"},
+		MinDuration: durationpb.New(time.Second),
+		Peers:       []string{"10.0.0.0/8", "192.168.0.1"},
+	})
+	s.Require().NoError(err)
+	done := make(chan struct{})
+	defer close(done)
+	notFound := status.Error(codes.NotFound, "WasHere")
+	go s.publish(done,
+		call("/example.OtherService/ExampleMethod", "10.0.0.1", time.Minute, notFound),
+		call("/example.ExampleService/ExampleMethod", "10.0.0.1", time.Minute, nil),
+		call("/example.ExampleService/ExampleMethod", "10.0.0.1", time.Millisecond, notFound),
+		call("/example.ExampleService/ExampleMethod", "172.16.0.1", time.Minute, notFound),
+		call("/example.ExampleService/ExampleMethod", "192.168.0.1", time.Minute, errors.New("WasHere")),
+		call("/example.ExampleService/ExampleMethod", "10.0.0.1", time.Minute, notFound),
+	)
+	event, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Equal("/example.ExampleService/ExampleMethod", event.Method)
+	s.Equal("NotFound", event.Code)
+	s.Equal("10.0.0.1", event.Peer)
+	s.True(event.Duration.AsDuration() >= time.Minute)
+	s.JSONEq(`{"level":"error","method":"/example.ExampleService/ExampleMethod","message":"unary"}`, string(event.Log))
+}
+
+func (s *ServerSuite) TestTailLogsUnauthorized() {
+	stream, err := s.client.TailLogs(context.Background(), &TailLogsRequest{})
+	s.Require().NoError(err)
+	_, err = stream.Recv()
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *ServerSuite) TestTailLogsInvalidPeer() {
+	stream, err := s.client.TailLogs(s.ctx, &TailLogsRequest{Peers: []string{"localhost"}})
+	s.Require().NoError(err)
+	_, err = stream.Recv()
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
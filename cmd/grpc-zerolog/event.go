@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/rs/zerolog"
+)
+
+// Event of a gRPC call logged by the interceptors, decoded from a JSON line with the field keys of gRPC Zerolog.
+type Event map[string]interface{}
+
+// ParseEvent of a JSON line.
+func ParseEvent(line []byte) (Event, error) {
+	d := json.NewDecoder(bytes.NewReader(line))
+	d.UseNumber()
+	e := Event{}
+	if err := d.Decode(&e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Str of a field, or empty if not a string.
+func (e Event) Str(key string) string {
+	s, _ := e[key].(string)
+	return s
+}
+
+// IsCall reports whether the event logs a gRPC call.
+func (e Event) IsCall() bool {
+	return e.Str(grpczerolog.MethodField) != ""
+}
+
+// Service of the call with its package, e.g. "example.ExampleService".
+func (e Event) Service() string {
+	if pkg := e.Str(grpczerolog.PackageField); pkg != "" {
+		return pkg + "." + e.Str(grpczerolog.ServiceField)
+	}
+	return e.Str(grpczerolog.ServiceField)
+}
+
+// Method of the call, e.g. "ExampleMethod".
+func (e Event) Method() string {
+	return e.Str(grpczerolog.MethodField)
+}
+
+// FullMethod of the call, e.g. "/example.ExampleService/ExampleMethod".
+func (e Event) FullMethod() string {
+	return "/" + e.Service() + "/" + e.Method()
+}
+
+// Code of the call status, which is only logged for errors.
+func (e Event) Code() string {
+	if code := e.Str(grpczerolog.CodeField); code != "" {
+		return code
+	}
+	return "OK"
+}
+
+// Level of the event.
+func (e Event) Level() string {
+	return e.Str(zerolog.LevelFieldName)
+}
+
+// Duration of the call, logged in unit (zerolog.DurationFieldUnit of the logging process).
+func (e Event) Duration(unit time.Duration) (time.Duration, bool) {
+	n, ok := e[grpczerolog.DurationField].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(f * float64(unit)), true
+}
+
+// RequestID of the call, from a top-level field or metadata key.
+func (e Event) RequestID(key string) string {
+	if id := e.Str(key); id != "" {
+		return id
+	}
+	md, _ := e[grpczerolog.MetadataField].(map[string]interface{})
+	switch v := md[key].(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			s, _ := v[0].(string)
+			return s
+		}
+	}
+	return ""
+}
+
+// Filter of call events. Empty filters match every event.
+type Filter struct {
+	// Service with or without its package, e.g. "example.ExampleService" or "ExampleService".
+	Service string
+	// Method name or full method, e.g. "ExampleMethod" or "/example.ExampleService/ExampleMethod".
+	Method string
+	// Codes of the call status, e.g. "OK" or "NotFound".
+	Codes []string
+	// MinDuration of calls.
+	MinDuration time.Duration
+	// RequestID of calls, from RequestIDKey.
+	RequestID string
+	// RequestIDKey of top-level fields or metadata.
+	RequestIDKey string
+	// DurationUnit of logged durations.
+	DurationUnit time.Duration
+}
+
+// IsEmpty reports whether the filter matches every event, including events other than calls.
+func (f Filter) IsEmpty() bool {
+	return f.Service == "" && f.Method == "" && len(f.Codes) == 0 && f.MinDuration == 0 && f.RequestID == ""
+}
+
+// Match reports whether a call event matches every filter.
+func (f Filter) Match(e Event) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if !e.IsCall() {
+		return false
+	}
+	if f.Service != "" && f.Service != e.Service() && f.Service != e.Str(grpczerolog.ServiceField) {
+		return false
+	}
+	if f.Method != "" && f.Method != e.Method() && f.Method != e.FullMethod() {
+		return false
+	}
+	if len(f.Codes) > 0 && !containsFold(f.Codes, e.Code()) {
+		return false
+	}
+	if f.MinDuration > 0 {
+		if d, ok := e.Duration(f.DurationUnit); !ok || d < f.MinDuration {
+			return false
+		}
+	}
+	return f.RequestID == "" || f.RequestID == e.RequestID(f.RequestIDKey)
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	okLine       = `{"level":"info","time":"2020-01-01T00:00:00Z","package":"example","service":"ExampleService","method":"ExampleMethod","grpc.kind":"unary","dur":12.5,"ip":"127.0.0.1","md":{"x-request-id":"PhilipB"},"req":{"test":"WasHere"},"message":"unary"}`
+	notFoundLine = `{"level":"error","time":"2020-01-01T00:00:01Z","service":"TestService","method":"TestUnary","dur":1500,"error":"rpc error: code = NotFound desc = WasHere","code":"NotFound","msg":"WasHere","md":{"x-request-id":["Philip","B"]},"message":"unary"}`
+)
+
+type EventSuite struct {
+	suite.Suite
+	ok       Event
+	notFound Event
+}
+
+func TestEvent(t *testing.T) {
+	suite.Run(t, new(EventSuite))
+}
+
+func (s *EventSuite) SetupTest() {
+	var err error
+	s.ok, err = ParseEvent([]byte(okLine))
+	s.Require().NoError(err)
+	s.notFound, err = ParseEvent([]byte(notFoundLine))
+	s.Require().NoError(err)
+}
+
+func (s *EventSuite) TestParseEvent() {
+	s.True(s.ok.IsCall())
+	s.Equal("example.ExampleService", s.ok.Service())
+	s.Equal("/example.ExampleService/ExampleMethod", s.ok.FullMethod())
+	s.Equal("OK", s.ok.Code())
+	s.Equal("info", s.ok.Level())
+	d, ok := s.ok.Duration(time.Millisecond)
+	s.True(ok)
+	s.Equal(12500*time.Microsecond, d)
+	s.Equal("PhilipB", s.ok.RequestID("x-request-id"))
+
+	s.Equal("/TestService/TestUnary", s.notFound.FullMethod())
+	s.Equal("NotFound", s.notFound.Code())
+	s.Equal("Philip", s.notFound.RequestID("x-request-id"))
+
+	_, err := ParseEvent([]byte("PhilipWasHere"))
+	s.Error(err)
+	e, err := ParseEvent([]byte(`{"level":"info","message":"start server"}`))
+	s.Require().NoError(err)
+	s.False(e.IsCall())
+	_, ok = e.Duration(time.Millisecond)
+	s.False(ok)
+}
+
+func (s *EventSuite) TestFilter() {
+	s.True(Filter{}.Match(s.ok))
+	s.True(Filter{Service: "example.ExampleService"}.Match(s.ok))
+	s.True(Filter{Service: "ExampleService"}.Match(s.ok))
+	s.False(Filter{Service: "TestService"}.Match(s.ok))
+	s.True(Filter{Method: "ExampleMethod"}.Match(s.ok))
+	s.True(Filter{Method: "/example.ExampleService/ExampleMethod"}.Match(s.ok))
+	s.False(Filter{Method: "/other.ExampleService/ExampleMethod"}.Match(s.ok))
+	s.True(Filter{Codes: []string{"notfound", "Internal"}}.Match(s.notFound))
+	s.False(Filter{Codes: []string{"NotFound"}}.Match(s.ok))
+	s.True(Filter{MinDuration: time.Second, DurationUnit: time.Millisecond}.Match(s.notFound))
+	s.False(Filter{MinDuration: time.Second, DurationUnit: time.Millisecond}.Match(s.ok))
+	s.True(Filter{RequestID: "PhilipB", RequestIDKey: "x-request-id"}.Match(s.ok))
+	s.False(Filter{RequestID: "PhilipB", RequestIDKey: "x-request-id"}.Match(s.notFound))
+
+	e, _ := ParseEvent([]byte(`{"level":"info","message":"start server"}`))
+	s.True(Filter{}.Match(e))
+	s.False(Filter{Codes: []string{"OK"}}.Match(e), "Expected events other than calls filtered")
+}
@@ -0,0 +1,64 @@
+// grpc-zerolog reads gRPC calls logged by gRPC Zerolog interceptors.
+//
+//	grpc-zerolog tail [flags] [file ...]
+//
+// Tail filters JSON lines of files, standard input or the LoggingAdmin TailLogs RPC of a server, by service, method,
+// code, duration and request ID, printing them colorized or as JSON.
+//
+//	kubectl logs -f deploy/example | grpc-zerolog tail -service example.ExampleService -code Internal,Unknown
+//	grpc-zerolog tail -addr localhost:8000 -H "x-admin-token: secret" -min-dur 500ms
+//
+//	grpc-zerolog replay [flags] [file]
+//
+// Replay prints a grpcurl command replaying the first logged call matching the filters, with its request and
+// metadata, or replays it with -exec using dynamic messages of a protoset.
+//
+//	grpc-zerolog replay -request-id 1234 -addr localhost:8000 app.log
+//	grpc-zerolog replay -request-id 1234 -addr localhost:8000 -protoset example.protoset -exec app.log
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	var err error
+	switch args[0] {
+	case "tail":
+		err = tail(args[1:], stdin, stdout, stderr)
+	case "replay":
+		err = replay(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "grpc-zerolog: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "grpc-zerolog:", err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: grpc-zerolog <command> [flags]
+
+Commands:
+  tail    filter and print gRPC calls logged by gRPC Zerolog interceptors
+  replay  print a grpcurl command replaying a logged call, or replay it
+
+Run "grpc-zerolog <command> -h" for flags.`)
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// errNoCall when no call in the input matches the filters.
+var errNoCall = errors.New("no matching call")
+
+func replay(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: grpc-zerolog replay [flags] [file]")
+		flags.PrintDefaults()
+	}
+	var (
+		f        Filter
+		codeList string
+		addr     string
+		useTLS   bool
+		headers  headerFlags
+		protoset string
+		exec     bool
+		timeout  time.Duration
+	)
+	flags.StringVar(&f.Service, "service", "", "service, with or without its package")
+	flags.StringVar(&f.Method, "method", "", "method name or full method, e.g. /package.Service/Method")
+	flags.StringVar(&codeList, "code", "", "comma-separated status codes, e.g. Internal,Unknown")
+	flags.StringVar(&f.RequestID, "request-id", "", "request ID of the call")
+	flags.StringVar(&f.RequestIDKey, "request-id-key", "x-request-id", "field or metadata key of request IDs")
+	flags.StringVar(&addr, "addr", "localhost:8080", "server to replay the call against")
+	flags.BoolVar(&useTLS, "tls", false, "connect to -addr with TLS")
+	flags.Var(&headers, "H", `additional metadata, e.g. "authorization: Bearer token" (repeatable)`)
+	flags.StringVar(&protoset, "protoset", "", "FileDescriptorSet of the service, from protoc --descriptor_set_out --include_imports")
+	flags.BoolVar(&exec, "exec", false, "replay the call with -protoset, instead of printing a grpcurl command")
+	flags.DurationVar(&timeout, "timeout", 30*time.Second, "timeout of replayed calls")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+	if codeList != "" {
+		f.Codes = strings.Split(codeList, ",")
+	}
+	if flags.NArg() > 1 {
+		return errors.New("replay reads one file")
+	}
+	name := "-"
+	if flags.NArg() == 1 {
+		name = flags.Arg(0)
+	}
+	e, err := findCall(name, stdin, f)
+	if err != nil {
+		return err
+	}
+	call, err := NewReplayCall(e, stderr)
+	if err != nil {
+		return err
+	}
+	md, err := headers.metadata()
+	if err != nil {
+		return err
+	}
+	for key, values := range md {
+		call.Metadata[key] = values
+	}
+	if !exec {
+		_, err := fmt.Fprintln(stdout, call.Grpcurl(addr, useTLS, protoset))
+		return err
+	}
+	if protoset == "" {
+		return errors.New("-exec requires -protoset")
+	}
+	files, err := loadProtoset(protoset)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return call.Invoke(ctx, addr, useTLS, files, stdout)
+}
+
+// findCall of name (or standard input for "-"), the first matching the filter.
+func findCall(name string, stdin io.Reader, f Filter) (Event, error) {
+	r := stdin
+	if name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		if e, err := ParseEvent(scanner.Bytes()); err == nil && e.IsCall() && f.Match(e) {
+			return e, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errNoCall
+}
+
+// ReplayCall of a logged call event.
+type ReplayCall struct {
+	// FullMethod of the call, e.g. "/package.Service/Method".
+	FullMethod string
+	// Request in JSON.
+	Request json.RawMessage
+	// Metadata of the call, without redacted and transport keys.
+	Metadata metadata.MD
+}
+
+// skippedMetadata set by gRPC transports, rather than clients.
+var skippedMetadata = map[string]bool{
+	"content-type":         true,
+	"user-agent":           true,
+	"te":                   true,
+	"grpc-accept-encoding": true,
+	"grpc-encoding":        true,
+	"grpc-timeout":         true,
+}
+
+// NewReplayCall of a logged call event, warning to w of metadata and requests that cannot be replayed as logged.
+func NewReplayCall(e Event, w io.Writer) (*ReplayCall, error) {
+	if e.Service() == "" || e.Method() == "" {
+		return nil, errors.New("call without service or method")
+	}
+	if e.Str(grpczerolog.PackageField) == "" && !strings.Contains(e.Service(), ".") {
+		fmt.Fprintln(w, "grpc-zerolog: warning: call without package, assuming its service has no package")
+	}
+	call := &ReplayCall{FullMethod: e.FullMethod(), Metadata: metadata.MD{}}
+	req, ok := e[grpczerolog.ReqField]
+	if !ok {
+		return nil, errors.New("call without logged request")
+	}
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	call.Request = b
+	if _, ok := e[grpczerolog.ReqField+grpczerolog.TruncatedSuffix]; ok {
+		fmt.Fprintln(w, "grpc-zerolog: warning: request was truncated when logged")
+	}
+	md, _ := e[grpczerolog.MetadataField].(map[string]interface{})
+	for key, value := range md {
+		if strings.HasPrefix(key, ":") || skippedMetadata[key] {
+			continue
+		}
+		values, ok := metadataValues(value)
+		if !ok {
+			fmt.Fprintf(w, "grpc-zerolog: warning: skipped metadata %q logged as JSON\n", key)
+			continue
+		}
+		for _, v := range values {
+			if v == grpczerolog.RedactedValue {
+				fmt.Fprintf(w, "grpc-zerolog: warning: skipped redacted metadata %q\n", key)
+				break
+			}
+			call.Metadata.Append(key, v)
+		}
+	}
+	return call, nil
+}
+
+func metadataValues(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, i := range v {
+			s, ok := i.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// Grpcurl command replaying the call against addr, with a protoset or else server reflection.
+func (c *ReplayCall) Grpcurl(addr string, useTLS bool, protoset string) string {
+	args := []string{"grpcurl"}
+	if !useTLS {
+		args = append(args, "-plaintext")
+	}
+	if protoset != "" {
+		args = append(args, "-protoset", shellQuote(protoset))
+	}
+	keys := make([]string, 0, len(c.Metadata))
+	for key := range c.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, v := range c.Metadata[key] {
+			args = append(args, "-H", shellQuote(key+": "+v))
+		}
+	}
+	args = append(args, "-d", shellQuote(string(c.Request)), shellQuote(addr), shellQuote(strings.TrimPrefix(c.FullMethod, "/")))
+	return strings.Join(args, " ")
+}
+
+// shellQuote s for POSIX shells, if needed.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./:@") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Invoke the call against addr with dynamic messages of files, writing the response in JSON to w.
+func (c *ReplayCall) Invoke(ctx context.Context, addr string, useTLS bool, files *protoregistry.Files, w io.Writer) error {
+	method, err := findMethod(files, c.FullMethod)
+	if err != nil {
+		return err
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return fmt.Errorf("%v is a streaming method", c.FullMethod)
+	}
+	types := newTypes(files)
+	req := dynamicpb.NewMessage(method.Input())
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true, Resolver: types}).Unmarshal(c.Request, req); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	md, err := c.decodeMetadata()
+	if err != nil {
+		return err
+	}
+	resp := dynamicpb.NewMessage(method.Output())
+	if err := conn.Invoke(metadata.NewOutgoingContext(ctx, md), c.FullMethod, req, resp); err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf("%v: %v", s.Code(), s.Message())
+	}
+	b, err := (protojson.MarshalOptions{Multiline: true, Resolver: types}).Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+// decodeMetadata of binary (-bin) keys, logged in base64.
+func (c *ReplayCall) decodeMetadata() (metadata.MD, error) {
+	md := metadata.MD{}
+	for key, values := range c.Metadata {
+		for _, v := range values {
+			if strings.HasSuffix(key, "-bin") {
+				b, err := base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return nil, fmt.Errorf("metadata %v: %w", key, err)
+				}
+				v = string(b)
+			}
+			md.Append(key, v)
+		}
+	}
+	return md, nil
+}
+
+func findMethod(files *protoregistry.Files, fullMethod string) (protoreflect.MethodDescriptor, error) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid method %q", fullMethod)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name[:i]))
+	if err != nil {
+		return nil, fmt.Errorf("service %v: %w", name[:i], err)
+	}
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a service", name[:i])
+	}
+	method := service.Methods().ByName(protoreflect.Name(name[i+1:]))
+	if method == nil {
+		return nil, fmt.Errorf("method %v not found in service %v", name[i+1:], name[:i])
+	}
+	return method, nil
+}
+
+// newTypes of dynamic messages and extensions of files, resolving google.protobuf.Any in JSON.
+func newTypes(files *protoregistry.Files) *protoregistry.Types {
+	types := &protoregistry.Types{}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		registerTypes(types, fd.Messages(), fd.Extensions())
+		return true
+	})
+	return types
+}
+
+func registerTypes(types *protoregistry.Types, messages protoreflect.MessageDescriptors, extensions protoreflect.ExtensionDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		_ = types.RegisterMessage(dynamicpb.NewMessageType(md))
+		registerTypes(types, md.Messages(), md.Extensions())
+	}
+	for i := 0; i < extensions.Len(); i++ {
+		_ = types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i)))
+	}
+}
+
+// loadProtoset of a FileDescriptorSet, including imports.
+func loadProtoset(name string) (*protoregistry.Files, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(b, set); err != nil {
+		return nil, fmt.Errorf("protoset %v: %w", name, err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("protoset %v: %w", name, err)
+	}
+	return files, nil
+}
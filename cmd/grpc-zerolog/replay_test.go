@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	protov1 "github.com/golang/protobuf/proto"
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/philip-bui/grpc-zerolog/test"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const replayLine = `{"level":"info","service":"TestService","method":"TestUnary","md":{":authority":"localhost",` +
+	`"content-type":"application/grpc","user-agent":"grpc-go/1.56.3","authorization":"[REDACTED]",` +
+	`"x-request-id":"PhilipB","x-tags":["Was","Here"],"x-trace-bin":"AQI=","x-json-bin":{"id":1}},` +
+	`"req":{"test":"Philip's"},"message":"unary"}`
+
+type ReplaySuite struct {
+	suite.Suite
+	stdin  *bytes.Buffer
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestReplay(t *testing.T) {
+	suite.Run(t, new(ReplaySuite))
+}
+
+func (s *ReplaySuite) SetupTest() {
+	s.stdin = bytes.NewBufferString(strings.Join([]string{okLine, "PhilipWasHere", replayLine}, "\n"))
+	s.stdout = &bytes.Buffer{}
+	s.stderr = &bytes.Buffer{}
+}
+
+func (s *ReplaySuite) run(args ...string) int {
+	return run(append([]string{"replay"}, args...), s.stdin, s.stdout, s.stderr)
+}
+
+func (s *ReplaySuite) TestGrpcurl() {
+	s.Equal(0, s.run("-request-id", "PhilipB", "-service", "TestService"), s.stderr.String())
+	s.Equal(`grpcurl -plaintext -H 'x-request-id: PhilipB' -H 'x-tags: Was' -H 'x-tags: Here' -H 'x-trace-bin: AQI=' `+
+		`-d '{"test":"Philip'\''s"}' localhost:8080 TestService/TestUnary`+"\n", s.stdout.String())
+	s.Contains(s.stderr.String(), `skipped redacted metadata "authorization"`)
+	s.Contains(s.stderr.String(), `skipped metadata "x-json-bin" logged as JSON`)
+}
+
+func (s *ReplaySuite) TestGrpcurlFlags() {
+	s.Equal(0, s.run("-method", "/TestService/TestUnary", "-tls", "-protoset", "test protos.pb", "-addr", "example.com:443",
+		"-H", "authorization: Bearer PhilipB"))
+	s.Equal(`grpcurl -protoset 'test protos.pb' -H 'authorization: Bearer PhilipB' -H 'x-request-id: PhilipB' `+
+		`-H 'x-tags: Was' -H 'x-tags: Here' -H 'x-trace-bin: AQI=' -d '{"test":"Philip'\''s"}' example.com:443 `+
+		`TestService/TestUnary`+"\n", s.stdout.String())
+}
+
+func (s *ReplaySuite) TestFirstCall() {
+	s.Equal(0, s.run())
+	s.Contains(s.stdout.String(), "example.ExampleService/ExampleMethod")
+	s.Contains(s.stdout.String(), `-d '{"test":"WasHere"}'`)
+}
+
+func (s *ReplaySuite) TestNoCall() {
+	s.Equal(1, s.run("-code", "NotFound"))
+	s.Contains(s.stderr.String(), errNoCall.Error())
+
+	s.stdin = bytes.NewBufferString(`{"level":"info","service":"TestService","method":"TestUnary","message":"unary"}`)
+	s.Equal(1, s.run())
+	s.Contains(s.stderr.String(), "call without logged request")
+
+	s.Equal(1, s.run(filepath.Join(s.T().TempDir(), "missing.log")))
+	s.Equal(1, s.run("Philip", "WasHere"))
+}
+
+func (s *ReplaySuite) TestExec() {
+	var md metadata.MD
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ = metadata.FromIncomingContext(ctx)
+		return handler(ctx, req)
+	}))
+	pb.RegisterTestServiceServer(server, &test.TestServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	fd := protodesc.ToFileDescriptorProto(protov1.MessageV2(&pb.TestMessage{}).ProtoReflect().Descriptor().ParentFile())
+	b, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{fd}})
+	s.Require().NoError(err)
+	protoset := filepath.Join(s.T().TempDir(), "test.protoset")
+	s.Require().NoError(os.WriteFile(protoset, b, 0o600))
+
+	s.Equal(0, s.run("-method", "TestUnary", "-addr", lis.Addr().String(), "-protoset", protoset, "-exec"),
+		s.stderr.String())
+	s.JSONEq(`{"test":"Philip's"}`, s.stdout.String())
+	s.Equal([]string{"PhilipB"}, md.Get("x-request-id"))
+	s.Equal([]string{"Was", "Here"}, md.Get("x-tags"))
+	s.Equal([]string{"\x01\x02"}, md.Get("x-trace-bin"))
+
+	s.SetupTest()
+	s.stdin = bytes.NewBufferString(strings.Replace(replayLine, "Philip's", "", 1))
+	s.Equal(1, s.run("-addr", lis.Addr().String(), "-protoset", protoset, "-exec"))
+	s.Contains(s.stderr.String(), "InvalidArgument: Empty message")
+
+	s.SetupTest()
+	s.Equal(1, s.run("-exec"))
+	s.Contains(s.stderr.String(), "-exec requires -protoset")
+}
+
+func (s *ReplaySuite) TestShellQuote() {
+	s.Equal("localhost:8080", shellQuote("localhost:8080"))
+	s.Equal("''", shellQuote(""))
+	s.Equal(`'Philip'\''s'`, shellQuote("Philip's"))
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/philip-bui/grpc-zerolog/admin"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// maxLineSize of JSON lines, exceeding the default MaxSize of logged bodies.
+const maxLineSize = 16 * 1024 * 1024
+
+func tail(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("tail", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: grpc-zerolog tail [flags] [file ...]")
+		flags.PrintDefaults()
+	}
+	var (
+		f        Filter
+		codeList string
+		addr     string
+		useTLS   bool
+		headers  headerFlags
+		color    string
+		p        = &Printer{Out: stdout}
+	)
+	flags.StringVar(&f.Service, "service", "", "service, with or without its package")
+	flags.StringVar(&f.Method, "method", "", "method name or full method, e.g. /package.Service/Method")
+	flags.StringVar(&codeList, "code", "", "comma-separated status codes, e.g. Internal,Unknown")
+	flags.DurationVar(&f.MinDuration, "min-dur", 0, "minimum duration of calls, e.g. 500ms")
+	flags.StringVar(&f.RequestID, "request-id", "", "request ID of calls")
+	flags.StringVar(&f.RequestIDKey, "request-id-key", "x-request-id", "field or metadata key of request IDs")
+	flags.DurationVar(&f.DurationUnit, "dur-unit", zerolog.DurationFieldUnit, "unit of logged durations")
+	flags.StringVar(&addr, "addr", "", "tail calls of a server serving LoggingAdmin, instead of files")
+	flags.BoolVar(&useTLS, "tls", false, "connect to -addr with TLS")
+	flags.Var(&headers, "H", `metadata sent to -addr, e.g. "x-admin-token: secret" (repeatable)`)
+	flags.BoolVar(&p.JSON, "json", false, "print matching JSON lines as is")
+	flags.BoolVar(&p.Fields, "fields", false, "print every field of calls")
+	flags.StringVar(&color, "color", "auto", "colorize output: auto, always or never")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+	if codeList != "" {
+		f.Codes = strings.Split(codeList, ",")
+	}
+	p.DurationUnit = f.DurationUnit
+	switch color {
+	case "always":
+		p.Color = true
+	case "auto":
+		p.Color = isTerminal(stdout)
+	case "never":
+	default:
+		return fmt.Errorf("invalid -color %q", color)
+	}
+	if addr != "" {
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+		return tailRPC(ctx, addr, useTLS, headers, f, p, stderr)
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, name := range files {
+		if err := tailFile(name, stdin, f, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tailFile(name string, stdin io.Reader, f Filter, p *Printer) error {
+	if name == "-" {
+		return tailReader(stdin, f, p)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return tailReader(file, f, p)
+}
+
+func tailReader(r io.Reader, f Filter, p *Printer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		if err := tailLine(scanner.Bytes(), f, p); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// tailLine prints a matching line. Lines other than JSON are only printed without filters.
+func tailLine(line []byte, f Filter, p *Printer) error {
+	e, err := ParseEvent(line)
+	if err != nil {
+		if f.IsEmpty() {
+			return p.PrintRaw(line)
+		}
+		return nil
+	}
+	if f.Match(e) {
+		return p.Print(line, e)
+	}
+	return nil
+}
+
+func tailRPC(ctx context.Context, addr string, useTLS bool, headers headerFlags, f Filter, p *Printer, stderr io.Writer) error {
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	md, err := headers.metadata()
+	if err != nil {
+		return err
+	}
+	stream, err := admin.NewLoggingAdminClient(conn).TailLogs(metadata.NewOutgoingContext(ctx, md), tailRequest(f))
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || status.Code(err) == codes.Canceled {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if event.Dropped > 0 {
+			fmt.Fprintf(stderr, "grpc-zerolog: %d calls dropped\n", event.Dropped)
+		}
+		if err := tailLine(withTimestamp(event.Log, event.Time.AsTime().Local()), f, p); err != nil {
+			return err
+		}
+	}
+}
+
+// withTimestamp of the time a call ended, unless its JSON line has a timestamp.
+func withTimestamp(line []byte, t time.Time) []byte {
+	if e, err := ParseEvent(line); err != nil || e[zerolog.TimestampFieldName] != nil {
+		return line
+	}
+	key, _ := json.Marshal(zerolog.TimestampFieldName)
+	value, _ := json.Marshal(t.Format(zerolog.TimeFieldFormat))
+	b := append(append(append([]byte("{"), key...), ':'), value...)
+	rest := bytes.TrimSpace(bytes.TrimSpace(line)[1:])
+	if !bytes.HasPrefix(rest, []byte("}")) {
+		b = append(b, ',')
+	}
+	return append(b, rest...)
+}
+
+// tailRequest filters calls on the server by method, code and duration, which are matched exactly.
+func tailRequest(f Filter) *admin.TailLogsRequest {
+	req := &admin.TailLogsRequest{}
+	if strings.HasPrefix(f.Method, "/") {
+		req.Methods = []string{f.Method}
+	} else if strings.Contains(f.Service, ".") {
+		if f.Method != "" {
+			req.Methods = []string{"/" + f.Service + "/" + f.Method}
+		} else {
+			req.Methods = []string{"/" + f.Service + "/*"}
+		}
+	}
+	for _, code := range f.Codes {
+		if name, ok := codeName(code); ok {
+			req.Codes = append(req.Codes, name)
+		}
+	}
+	if f.MinDuration > 0 {
+		req.MinDuration = durationpb.New(f.MinDuration)
+	}
+	return req
+}
+
+// codeName of a status code in any case, e.g. "NotFound" of "notfound".
+func codeName(s string) (string, bool) {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if strings.EqualFold(c.String(), s) {
+			return c.String(), true
+		}
+	}
+	return "", false
+}
+
+// headerFlags of "key: value" metadata.
+type headerFlags []string
+
+func (h *headerFlags) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headerFlags) Set(s string) error {
+	*h = append(*h, s)
+	return nil
+}
+
+func (h headerFlags) metadata() (metadata.MD, error) {
+	md := metadata.MD{}
+	for _, header := range h {
+		i := strings.Index(header, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid header %q, expected \"key: value\"", header)
+		}
+		md.Append(strings.TrimSpace(header[:i]), strings.TrimSpace(header[i+1:]))
+	}
+	return md, nil
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+const (
+	colorRed    = 31
+	colorGreen  = 32
+	colorYellow = 33
+	colorCyan   = 36
+	colorGray   = 90
+	colorBold   = 1
+)
+
+// Printer of call events, in a human-readable line or JSON.
+type Printer struct {
+	Out io.Writer
+	// Color output with ANSI escape codes.
+	Color bool
+	// JSON lines printed as is.
+	JSON bool
+	// Fields of calls printed besides the summary.
+	Fields bool
+	// DurationUnit of logged durations.
+	DurationUnit time.Duration
+}
+
+// PrintRaw line, other than JSON.
+func (p *Printer) PrintRaw(line []byte) error {
+	_, err := fmt.Fprintf(p.Out, "%s\n", line)
+	return err
+}
+
+// Print an event, decoded from line.
+//
+//	2020-01-01T00:00:00Z INF /example.ExampleService/ExampleMethod OK 12ms 127.0.0.1 unary
+func (p *Printer) Print(line []byte, e Event) error {
+	if p.JSON {
+		return p.PrintRaw(line)
+	}
+	var parts []string
+	shown := map[string]bool{
+		zerolog.TimestampFieldName: true,
+		zerolog.LevelFieldName:     true,
+		zerolog.MessageFieldName:   true,
+	}
+	if t := e.Str(zerolog.TimestampFieldName); t != "" {
+		parts = append(parts, p.colorize(t, colorGray))
+	}
+	if level := e.Level(); level != "" {
+		parts = append(parts, p.level(level))
+	}
+	if e.IsCall() {
+		code := e.Code()
+		codeColor := colorGreen
+		if code != "OK" {
+			codeColor = colorRed
+		}
+		parts = append(parts, p.colorize(e.FullMethod(), colorBold), p.colorize(code, codeColor))
+		if d, ok := e.Duration(p.DurationUnit); ok {
+			parts = append(parts, p.colorize(d.Round(time.Microsecond).String(), colorCyan))
+		}
+		if ip := e.Str(grpczerolog.IPField); ip != "" {
+			parts = append(parts, ip)
+		}
+		for _, key := range []string{grpczerolog.PackageField, grpczerolog.ServiceField, grpczerolog.MethodField,
+			grpczerolog.CodeField, grpczerolog.DurationField, grpczerolog.IPField} {
+			shown[key] = true
+		}
+	}
+	if msg := e.Str(zerolog.MessageFieldName); msg != "" {
+		parts = append(parts, msg)
+	}
+	if msg := e.Str(grpczerolog.MsgField); msg != "" && e.IsCall() {
+		parts = append(parts, p.colorize(fmt.Sprintf("%q", msg), colorRed))
+		shown[grpczerolog.MsgField] = true
+	}
+	if p.Fields || !e.IsCall() {
+		parts = append(parts, p.fields(e, shown)...)
+	}
+	_, err := fmt.Fprintln(p.Out, strings.Join(parts, " "))
+	return err
+}
+
+func (p *Printer) fields(e Event, shown map[string]bool) []string {
+	var keys []string
+	for key := range e {
+		if !shown[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		b, _ := json.Marshal(e[key])
+		parts = append(parts, p.colorize(key+"=", colorGray)+string(bytes.TrimSpace(b)))
+	}
+	return parts
+}
+
+func (p *Printer) level(level string) string {
+	l, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return level
+	}
+	switch l {
+	case zerolog.TraceLevel, zerolog.DebugLevel:
+		return p.colorize(strings.ToUpper(level[:3]), colorGray)
+	case zerolog.InfoLevel:
+		return p.colorize("INF", colorGreen)
+	case zerolog.WarnLevel:
+		return p.colorize("WRN", colorYellow)
+	case zerolog.NoLevel:
+		return "???"
+	default:
+		return p.colorize(strings.ToUpper(level[:3]), colorRed)
+	}
+}
+
+func (p *Printer) colorize(s string, color int) string {
+	if !p.Color {
+		return s
+	}
+	return fmt.Sprintf("\x1b[%dm%s\x1b[0m", color, s)
+}
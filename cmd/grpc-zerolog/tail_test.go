@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	grpczerolog "github.com/philip-bui/grpc-zerolog"
+	"github.com/philip-bui/grpc-zerolog/admin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type TailSuite struct {
+	suite.Suite
+	stdin  *bytes.Buffer
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func TestTail(t *testing.T) {
+	suite.Run(t, new(TailSuite))
+}
+
+func (s *TailSuite) SetupTest() {
+	s.stdin = bytes.NewBufferString(strings.Join([]string{okLine, "PhilipWasHere", notFoundLine}, "\n"))
+	s.stdout = &bytes.Buffer{}
+	s.stderr = &bytes.Buffer{}
+}
+
+func (s *TailSuite) run(args ...string) int {
+	return run(args, s.stdin, s.stdout, s.stderr)
+}
+
+func (s *TailSuite) TestTail() {
+	s.Equal(0, s.run("tail"))
+	s.Equal(`2020-01-01T00:00:00Z INF /example.ExampleService/ExampleMethod OK 12.5ms 127.0.0.1 unary
+PhilipWasHere
+2020-01-01T00:00:01Z ERR /TestService/TestUnary NotFound 1.5s unary "WasHere"
+`, s.stdout.String())
+}
+
+func (s *TailSuite) TestTailFilter() {
+	s.Equal(0, s.run("tail", "-code", "NotFound,Internal", "-min-dur", "1s", "-json"))
+	s.Equal(notFoundLine+"\n", s.stdout.String())
+
+	s.stdout.Reset()
+	s.SetupTest()
+	s.Equal(0, s.run("tail", "-service", "example.ExampleService", "-request-id", "PhilipB", "-fields"))
+	s.Equal(`2020-01-01T00:00:00Z INF /example.ExampleService/ExampleMethod OK 12.5ms 127.0.0.1 unary `+
+		`grpc.kind="unary" md={"x-request-id":"PhilipB"} req={"test":"WasHere"}
+`, s.stdout.String())
+}
+
+func (s *TailSuite) TestTailColor() {
+	s.Equal(0, s.run("tail", "-color", "always", "-code", "NotFound"))
+	s.Contains(s.stdout.String(), "\x1b[31mNotFound\x1b[0m")
+	s.Contains(s.stdout.String(), "\x1b[31mERR\x1b[0m")
+	s.Equal(1, s.run("tail", "-color", "rainbow"))
+}
+
+func (s *TailSuite) TestTailFiles() {
+	name := filepath.Join(s.T().TempDir(), "grpc.log")
+	s.Require().NoError(os.WriteFile(name, []byte(okLine+"\n"), 0o600))
+	s.Equal(0, s.run("tail", "-json", name, name))
+	s.Equal(okLine+"\n"+okLine+"\n", s.stdout.String())
+	s.Equal(1, s.run("tail", filepath.Join(s.T().TempDir(), "missing.log")))
+}
+
+func (s *TailSuite) TestTailRPC() {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := grpc.NewServer()
+	admin.Register(server, func(ctx context.Context, method string) error {
+		if md, _ := metadata.FromIncomingContext(ctx); strings.Join(md.Get("x-admin-token"), "") != "PhilipB" {
+			return status.Error(codes.PermissionDenied, "invalid admin token")
+		}
+		return nil
+	})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := &syncBuffer{}
+	go func() {
+		for !strings.Contains(out.String(), "\n") {
+			grpczerolog.PublishCall(context.Background(), "/example.ExampleService/ExampleMethod", time.Now(),
+				status.Error(codes.Internal, "WasHere"), func(e *zerolog.Event) {
+					e.Str("service", "ExampleService").Str("method", "ExampleMethod").Str("code", "Internal").Msg("unary")
+				})
+			time.Sleep(10 * time.Millisecond)
+		}
+		cancel()
+	}()
+	s.NoError(tailRPC(ctx, lis.Addr().String(), false, headerFlags{"x-admin-token: PhilipB"},
+		Filter{Codes: []string{"internal"}}, &Printer{Out: out, JSON: true}, s.stderr))
+	s.Regexp(`^\{"time":"[^"]+","level":"error","service":"ExampleService","method":"ExampleMethod","code":"Internal",`+
+		`"message":"unary"\}\n$`, strings.SplitAfter(out.String(), "\n")[0])
+}
+
+func (s *TailSuite) TestWithTimestamp() {
+	t := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Equal(`{"time":"2020-01-01T00:00:00Z","level":"info"}`, string(withTimestamp([]byte(`{"level":"info"}`), t)))
+	s.Equal(`{"time":"2020-01-01T00:00:00Z"}`, string(withTimestamp([]byte(`{}`), t)))
+	s.Equal(`{"time":"PhilipWasHere"}`, string(withTimestamp([]byte(`{"time":"PhilipWasHere"}`), t)),
+		"Expected logged timestamps kept")
+	s.Equal("PhilipWasHere", string(withTimestamp([]byte("PhilipWasHere"), t)))
+}
+
+// syncBuffer for concurrent writes and reads.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+func (s *TailSuite) TestTailRPCUnauthorized() {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := grpc.NewServer()
+	admin.Register(server, func(context.Context, string) error {
+		return status.Error(codes.PermissionDenied, "invalid admin token")
+	})
+	go server.Serve(lis)
+	defer server.Stop()
+	s.Equal(1, s.run("tail", "-addr", lis.Addr().String()))
+	s.Contains(s.stderr.String(), "PermissionDenied")
+	s.Equal(1, s.run("tail", "-addr", lis.Addr().String(), "-H", "x-admin-token"))
+}
+
+func (s *TailSuite) TestTailRequest() {
+	s.Equal([]string{"/example.ExampleService/*"}, tailRequest(Filter{Service: "example.ExampleService"}).Methods)
+	s.Equal([]string{"/example.ExampleService/ExampleMethod"},
+		tailRequest(Filter{Service: "example.ExampleService", Method: "ExampleMethod"}).Methods)
+	s.Equal([]string{"/example.ExampleService/ExampleMethod"},
+		tailRequest(Filter{Method: "/example.ExampleService/ExampleMethod"}).Methods)
+	s.Empty(tailRequest(Filter{Service: "ExampleService", Method: "ExampleMethod"}).Methods)
+	s.Equal(time.Second, tailRequest(Filter{MinDuration: time.Second}).MinDuration.AsDuration())
+	s.Equal([]string{"NotFound", "Internal"}, tailRequest(Filter{Codes: []string{"notfound", "Internal", "Philip"}}).Codes)
+}
+
+func (s *TailSuite) TestUsage() {
+	s.Equal(2, s.run())
+	s.Equal(2, s.run("PhilipWasHere"))
+	s.Equal(0, s.run("help"))
+	s.Contains(s.stdout.String(), "tail")
+	s.Equal(0, s.run("tail", "-h"))
+}
@@ -0,0 +1,269 @@
+// protoc-gen-go-zerolog generates MarshalZerologObject methods for Protobuf messages, so gRPC Zerolog
+// interceptors log payloads without reflection or an intermediate JSON encoding.
+//
+//	protoc -I protos/ protos/*.proto --go_out=protos --go-zerolog_out=protos
+//
+// Fields with a leading or trailing comment containing "zerolog:sensitive", or the debug_redact option, are never
+// logged.
+// Size limits are applied at generation time with plugin parameters.
+//
+//	--go-zerolog_opt=max_string=256,max_bytes=64,max_repeated=16
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	// SensitiveDirective in a field comment omits the field from logs.
+	SensitiveDirective = "zerolog:sensitive"
+
+	zerologPackage     = protogen.GoImportPath("github.com/rs/zerolog")
+	grpcZerologPackage = protogen.GoImportPath("github.com/philip-bui/grpc-zerolog")
+	base64Package      = protogen.GoImportPath("encoding/base64")
+	sortPackage        = protogen.GoImportPath("sort")
+	strconvPackage     = protogen.GoImportPath("strconv")
+)
+
+// Limits applied to generated marshalers. Zero is unlimited.
+type Limits struct {
+	MaxString   int
+	MaxBytes    int
+	MaxRepeated int
+}
+
+func main() {
+	var flags flag.FlagSet
+	limits := Limits{}
+	flags.IntVar(&limits.MaxString, "max_string", 0, "maximum runes logged of string fields")
+	flags.IntVar(&limits.MaxBytes, "max_bytes", 0, "maximum bytes logged of bytes fields")
+	flags.IntVar(&limits.MaxRepeated, "max_repeated", 0, "maximum elements logged of repeated fields")
+	protogen.Options{
+		ParamFunc: flags.Set,
+	}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		for _, f := range gen.Files {
+			if f.Generate {
+				GenerateFile(gen, f, limits)
+			}
+		}
+		return nil
+	})
+}
+
+// GenerateFile writes <file>.pb.zerolog.go with marshalers for every message in f.
+func GenerateFile(gen *protogen.Plugin, f *protogen.File, limits Limits) *protogen.GeneratedFile {
+	messages := collectMessages(f.Messages)
+	if len(messages) == 0 {
+		return nil
+	}
+	g := gen.NewGeneratedFile(f.GeneratedFilenamePrefix+".pb.zerolog.go", f.GoImportPath)
+	g.P("// Code generated by protoc-gen-go-zerolog. DO NOT EDIT.")
+	g.P("// source: ", f.Desc.Path())
+	g.P()
+	g.P("package ", f.GoPackageName)
+	g.P()
+	for _, m := range messages {
+		generateMessage(g, m, limits)
+	}
+	return g
+}
+
+// collectMessages flattens nested messages, skipping synthetic map entries.
+func collectMessages(messages []*protogen.Message) []*protogen.Message {
+	var all []*protogen.Message
+	for _, m := range messages {
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+		all = append(all, m)
+		all = append(all, collectMessages(m.Messages)...)
+	}
+	return all
+}
+
+// IsSensitive reports whether a field is annotated with SensitiveDirective or the debug_redact option. Comments are
+// only available when protoc passes source info, while options are always set.
+func IsSensitive(field *protogen.Field) bool {
+	if opts, ok := field.Desc.Options().(*descriptorpb.FieldOptions); ok && opts.GetDebugRedact() {
+		return true
+	}
+	return strings.Contains(string(field.Comments.Leading), SensitiveDirective) ||
+		strings.Contains(string(field.Comments.Trailing), SensitiveDirective)
+}
+
+func generateMessage(g *protogen.GeneratedFile, m *protogen.Message, limits Limits) {
+	event := g.QualifiedGoIdent(zerologPackage.Ident("Event"))
+	g.P("// MarshalZerologObject implements zerolog.LogObjectMarshaler.")
+	g.P("func (x *", m.GoIdent, ") MarshalZerologObject(e *", event, ") {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	for _, field := range m.Fields {
+		if IsSensitive(field) {
+			continue
+		}
+		generateField(g, field, limits)
+	}
+	g.P("}")
+	g.P()
+}
+
+func generateField(g *protogen.GeneratedFile, field *protogen.Field, limits Limits) {
+	key := fmt.Sprintf("%q", field.Desc.JSONName())
+	getter := "x.Get" + field.GoName + "()"
+	switch {
+	case field.Desc.IsMap():
+		generateMap(g, field, key, getter, limits)
+	case field.Desc.IsList():
+		generateList(g, field, key, getter, limits)
+	case isMessage(field.Desc):
+		g.P("if v := ", getter, "; v != nil {")
+		g.P(grpcZerologPackage.Ident("LogObject"), "(e, ", key, ", v)")
+		g.P("}")
+	default:
+		g.P("if v := ", getter, "; ", notZero(field.Desc, "v"), " {")
+		g.P("e.", scalar(g, field.Desc, "v", limits, key))
+		g.P("}")
+	}
+}
+
+func generateList(g *protogen.GeneratedFile, field *protogen.Field, key, getter string, limits Limits) {
+	g.P("if v := ", getter, "; len(v) > 0 {")
+	if limits.MaxRepeated > 0 {
+		g.P("if len(v) > ", limits.MaxRepeated, " {")
+		g.P("v = v[:", limits.MaxRepeated, "]")
+		g.P("}")
+	}
+	g.P("arr := ", zerologPackage.Ident("Arr"), "()")
+	g.P("for _, i := range v {")
+	if isMessage(field.Desc) {
+		g.P(grpcZerologPackage.Ident("LogArrayObject"), "(arr, i)")
+	} else {
+		g.P("arr.", scalar(g, field.Desc, "i", limits, ""))
+	}
+	g.P("}")
+	g.P("e.Array(", key, ", arr)")
+	g.P("}")
+}
+
+func generateMap(g *protogen.GeneratedFile, field *protogen.Field, key, getter string, limits Limits) {
+	k, v := field.Message.Fields[0], field.Message.Fields[1]
+	less := "keys[i] < keys[j]"
+	if k.Desc.Kind() == protoreflect.BoolKind {
+		less = "!keys[i] && keys[j]"
+	}
+	g.P("if v := ", getter, "; len(v) > 0 {")
+	g.P("keys := make([]", goType(k.Desc), ", 0, len(v))")
+	g.P("for k := range v {")
+	g.P("keys = append(keys, k)")
+	g.P("}")
+	g.P(sortPackage.Ident("Slice"), "(keys, func(i, j int) bool { return ", less, " })")
+	g.P("dict := ", zerologPackage.Ident("Dict"), "()")
+	g.P("for _, k := range keys {")
+	name := mapKey(g, k.Desc, "k")
+	if isMessage(v.Desc) {
+		g.P(grpcZerologPackage.Ident("LogObject"), "(dict, ", name, ", v[k])")
+	} else {
+		g.P("dict.", scalar(g, v.Desc, "v[k]", limits, name))
+	}
+	g.P("}")
+	g.P("e.Dict(", key, ", dict)")
+	g.P("}")
+}
+
+// scalar returns a zerolog Event or Array method call logging v. Key is omitted for Arrays.
+func scalar(g *protogen.GeneratedFile, fd protoreflect.FieldDescriptor, v string, limits Limits, key string) string {
+	if key != "" {
+		key += ", "
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "Bool(" + key + v + ")"
+	case protoreflect.EnumKind:
+		return "Str(" + key + v + ".String())"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "Int32(" + key + v + ")"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "Int64(" + key + v + ")"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "Uint32(" + key + v + ")"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "Uint64(" + key + v + ")"
+	case protoreflect.FloatKind:
+		return "Float32(" + key + v + ")"
+	case protoreflect.DoubleKind:
+		return "Float64(" + key + v + ")"
+	case protoreflect.StringKind:
+		if limits.MaxString > 0 {
+			truncate := g.QualifiedGoIdent(grpcZerologPackage.Ident("TruncateString"))
+			return fmt.Sprintf("Str(%s%s(%s, %d))", key, truncate, v, limits.MaxString)
+		}
+		return "Str(" + key + v + ")"
+	case protoreflect.BytesKind:
+		encode := g.QualifiedGoIdent(base64Package.Ident("StdEncoding"))
+		if limits.MaxBytes > 0 {
+			truncate := g.QualifiedGoIdent(grpcZerologPackage.Ident("TruncateBytes"))
+			return fmt.Sprintf("Str(%s%s.EncodeToString(%s(%s, %d)))", key, encode, truncate, v, limits.MaxBytes)
+		}
+		return "Str(" + key + encode + ".EncodeToString(" + v + "))"
+	}
+	panic("unhandled field kind " + fd.Kind().String())
+}
+
+// notZero returns a condition matching jsonpb, which omits fields with zero values.
+func notZero(fd protoreflect.FieldDescriptor, v string) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind:
+		return v + ` != ""`
+	case protoreflect.BytesKind:
+		return "len(" + v + ") > 0"
+	default:
+		return v + " != 0"
+	}
+}
+
+// mapKey returns a string expression of a map key.
+func mapKey(g *protogen.GeneratedFile, fd protoreflect.FieldDescriptor, k string) string {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return k
+	case protoreflect.BoolKind:
+		return g.QualifiedGoIdent(strconvPackage.Ident("FormatBool")) + "(" + k + ")"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return g.QualifiedGoIdent(strconvPackage.Ident("FormatUint")) + "(uint64(" + k + "), 10)"
+	default:
+		return g.QualifiedGoIdent(strconvPackage.Ident("FormatInt")) + "(int64(" + k + "), 10)"
+	}
+}
+
+// goType of a map key field.
+func goType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	default:
+		return "uint64"
+	}
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func field(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  label.Enum(),
+		Type:   kind.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+func mapEntry(name string, value descriptorpb.FieldDescriptorProto_Type) *descriptorpb.DescriptorProto {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	return &descriptorpb.DescriptorProto{
+		Name: proto.String(name),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			field("key", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+			field("value", 2, optional, value, ""),
+		},
+		Options: &descriptorpb.MessageOptions{
+			MapEntry: proto.Bool(true),
+		},
+	}
+}
+
+// generate marshalers of an example file, and vets them with the Go types generated by protoc-gen-go.
+func generate(t *testing.T, limits Limits) string {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	token := field("token", 7, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	token.Options = &descriptorpb.FieldOptions{
+		DebugRedact: proto.Bool(true),
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("example.proto"),
+		Package: proto.String("example"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/example"),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Example"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("password", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("tags", 3, repeated, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("counts", 4, repeated, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.Example.CountsEntry"),
+				field("child", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.Example"),
+				field("data", 6, optional, descriptorpb.FieldDescriptorProto_TYPE_BYTES, ""),
+				token,
+				field("labels", 8, repeated, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.Example.LabelsEntry"),
+			},
+			NestedType: []*descriptorpb.DescriptorProto{
+				mapEntry("CountsEntry", descriptorpb.FieldDescriptorProto_TYPE_INT32),
+				mapEntry("LabelsEntry", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{
+			Location: []*descriptorpb.SourceCodeInfo_Location{{
+				Path:            []int32{4, 0, 2, 1},
+				Span:            []int32{0, 0, 0},
+				LeadingComments: proto.String(" zerolog:sensitive\n"),
+			}},
+		},
+	}
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"example.proto"},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	})
+	require.NoError(t, err)
+	for _, f := range gen.Files {
+		if f.Generate {
+			internal_gengo.GenerateFile(gen, f)
+			GenerateFile(gen, f, limits)
+		}
+	}
+	resp := gen.Response()
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.File, 2)
+	assert.Equal(t, "example.com/example/example.pb.zerolog.go", resp.File[1].GetName())
+	vet(t, resp.File)
+	return resp.File[1].GetContent()
+}
+
+// vet generated files as one package with go vet, which fails on code that does not compile.
+func vet(t *testing.T, generated []*pluginpb.CodeGeneratorResponse_File) {
+	require.NoError(t, os.MkdirAll("testdata", 0755))
+	defer os.Remove("testdata")
+	dir, err := os.MkdirTemp("testdata", "example")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	for _, f := range generated {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, filepath.Base(f.GetName())), []byte(f.GetContent()), 0644))
+	}
+	out, err := exec.Command("go", "vet", "./"+dir).CombinedOutput()
+	require.NoError(t, err, "%s\n%s", out, generated[1].GetContent())
+}
+
+func TestGenerateFile(t *testing.T) {
+	content := generate(t, Limits{})
+	assert.Contains(t, content, "func (x *Example) MarshalZerologObject(e *zerolog.Event) {")
+	assert.Contains(t, content, `e.Str("name", v)`)
+	assert.NotContains(t, content, "password")
+	assert.NotContains(t, content, "token", "Expected debug_redact fields omitted")
+	assert.Contains(t, content, `e.Array("tags", arr)`)
+	assert.Contains(t, content, `dict.Int32(k, v[k])`)
+	assert.Contains(t, content, `grpc_zerolog.LogObject(e, "child", v)`)
+	assert.Contains(t, content, `e.Str("data", base64.StdEncoding.EncodeToString(v))`)
+	assert.NotContains(t, content, "CountsEntry) MarshalZerologObject")
+}
+
+func TestGenerateFileLimits(t *testing.T) {
+	content := generate(t, Limits{MaxString: 8, MaxBytes: 4, MaxRepeated: 2})
+	assert.Contains(t, content, `e.Str("name", grpc_zerolog.TruncateString(v, 8))`)
+	assert.Contains(t, content, `e.Str("data", base64.StdEncoding.EncodeToString(grpc_zerolog.TruncateBytes(v, 4)))`)
+	assert.Contains(t, content, "v = v[:2]")
+	assert.Contains(t, content, `dict.Str(k, grpc_zerolog.TruncateString(v[k], 8))`)
+}
@@ -0,0 +1,88 @@
+package zerolog
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	fieldMasksMu   sync.RWMutex
+	reqFieldMasks  = map[string][]string{}
+	respFieldMasks = map[string][]string{}
+)
+
+// SetFieldMask of gRPC method (e.g. "/package.Service/Method"), restricting the request and response fields logged.
+// A nil FieldMask logs every field.
+func SetFieldMask(method string, req, resp *fieldmaskpb.FieldMask) {
+	SetFieldMaskPaths(method, req.GetPaths(), resp.GetPaths())
+}
+
+// SetFieldMaskPaths of gRPC method (e.g. "/package.Service/Method"), restricting the request and response fields
+// logged to Protobuf field paths (e.g. "order.status"). Nil paths logs every field.
+func SetFieldMaskPaths(method string, req, resp []string) {
+	fieldMasksMu.Lock()
+	defer fieldMasksMu.Unlock()
+	setPaths(reqFieldMasks, method, req)
+	setPaths(respFieldMasks, method, resp)
+}
+
+func setPaths(masks map[string][]string, method string, paths []string) {
+	if paths == nil {
+		delete(masks, method)
+	} else {
+		masks[method] = paths
+	}
+}
+
+// ProjectRequest of gRPC method to its request FieldMask, if set.
+func ProjectRequest(method string, req interface{}) interface{} {
+	return project(reqFieldMasks, method, req)
+}
+
+// ProjectResponse of gRPC method to its response FieldMask, if set.
+func ProjectResponse(method string, resp interface{}) interface{} {
+	return project(respFieldMasks, method, resp)
+}
+
+func project(masks map[string][]string, method string, i interface{}) interface{} {
+	fieldMasksMu.RLock()
+	paths, ok := masks[method]
+	fieldMasksMu.RUnlock()
+	if pb, isProto := i.(proto.Message); ok && isProto {
+		return Project(pb, paths)
+	}
+	return i
+}
+
+// Project a deep copy of a Protobuf message, with only fields of paths.
+func Project(pb proto.Message, paths []string) proto.Message {
+	src := proto.MessageV2(pb).ProtoReflect()
+	dst := src.New()
+	for _, path := range paths {
+		projectPath(src, dst, strings.Split(path, "."))
+	}
+	return proto.MessageV1(dst.Interface())
+}
+
+func projectPath(src, dst protoreflect.Message, path []string) {
+	fd := src.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !src.Has(fd) {
+		return
+	}
+	if len(path) == 1 || fd.IsList() || fd.IsMap() || !isMessageKind(fd) {
+		dst.Set(fd, cloneField(src, fd))
+		return
+	}
+	projectPath(src.Get(fd).Message(), dst.Mutable(fd).Message(), path[1:])
+}
+
+// cloneField of a message, as messages, lists, maps and bytes of values are shared with their message.
+func cloneField(src protoreflect.Message, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	m := src.New()
+	m.Set(fd, src.Get(fd))
+	return proto.MessageV2(proto.Clone(proto.MessageV1(m.Interface()))).ProtoReflect().Get(fd)
+}
@@ -0,0 +1,76 @@
+package zerolog
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type TestFieldMaskSuite struct {
+	suite.Suite
+	method string
+	msg    *descriptorpb.DescriptorProto
+}
+
+func (s *TestFieldMaskSuite) SetupTest() {
+	s.method = "/philip.Service/Method"
+	s.msg = &descriptorpb.DescriptorProto{
+		Name: proto.String("Philip"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: proto.String("was")},
+		},
+		Options: &descriptorpb.MessageOptions{
+			Deprecated: proto.Bool(true),
+			MapEntry:   proto.Bool(true),
+		},
+	}
+}
+
+func (s *TestFieldMaskSuite) TearDownTest() {
+	SetFieldMaskPaths(s.method, nil, nil)
+}
+
+func TestFieldMask(t *testing.T) {
+	suite.Run(t, new(TestFieldMaskSuite))
+}
+
+func (s *TestFieldMaskSuite) TestProject() {
+	projected := Project(s.msg, []string{"name", "field", "options.deprecated", "philip"})
+	s.True(proto.Equal(&descriptorpb.DescriptorProto{
+		Name:  proto.String("Philip"),
+		Field: s.msg.Field,
+		Options: &descriptorpb.MessageOptions{
+			Deprecated: proto.Bool(true),
+		},
+	}, projected))
+}
+
+func (s *TestFieldMaskSuite) TestProjectCopy() {
+	projected := Project(s.msg, []string{"field", "options"}).(*descriptorpb.DescriptorProto)
+	projected.Field[0].Name = proto.String("Here")
+	projected.Options.Deprecated = proto.Bool(false)
+	s.Equal("was", s.msg.Field[0].GetName(), "Expected repeated fields copied")
+	s.True(s.msg.Options.GetDeprecated(), "Expected messages copied")
+}
+
+func (s *TestFieldMaskSuite) TestProjectRequest() {
+	SetFieldMask(s.method, &fieldmaskpb.FieldMask{Paths: []string{"name"}}, nil)
+	s.True(proto.Equal(&descriptorpb.DescriptorProto{
+		Name: proto.String("Philip"),
+	}, ProjectRequest(s.method, s.msg).(proto.Message)))
+	s.Equal(s.msg, ProjectResponse(s.method, s.msg))
+}
+
+func (s *TestFieldMaskSuite) TestProjectResponse() {
+	SetFieldMaskPaths(s.method, nil, []string{})
+	s.True(proto.Equal(&pb.TestMessage{}, ProjectResponse(s.method, &pb.TestMessage{Test: "Philip"}).(proto.Message)))
+	s.Equal(s.msg, ProjectRequest(s.method, s.msg))
+}
+
+func (s *TestFieldMaskSuite) TestProjectUnset() {
+	s.Equal(s.msg, ProjectRequest("/philip.Service/Other", s.msg))
+}
@@ -0,0 +1,86 @@
+package zerolog
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	// GrpcComponentField key of gRPC internal log component, e.g. "core" or "transport".
+	GrpcComponentField = "component"
+	// GrpcChannelField key of gRPC internal log channel ID.
+	GrpcChannelField = "channel_id"
+	// GrpcSubChannelField key of gRPC internal log subchannel ID.
+	GrpcSubChannelField = "subchannel_id"
+	// GrpcStateField key of gRPC internal log connectivity state.
+	GrpcStateField = "state"
+
+	grpcComponentRegexp = regexp.MustCompile(`^\[([\w-]+)\] `)
+	grpcEntityRegexp    = regexp.MustCompile(`^\[((?:\w+ #\d+ ?)+)\] `)
+	grpcIDRegexp        = regexp.MustCompile(`(\w+) #(\d+)`)
+	grpcStateRegexp     = regexp.MustCompile(`(?i)connectivity change to (\w+)`)
+)
+
+// GrpcLog message of gRPC internal logs, e.g. "[core] [Channel #3 SubChannel #4] Subchannel Connectivity change to READY".
+type GrpcLog struct {
+	// Component of gRPC, e.g. "core".
+	Component string
+	// ChannelID of channelz, if assigned.
+	ChannelID int64
+	// SubChannelID of channelz, if assigned.
+	SubChannelID int64
+	// State of connectivity changes, e.g. "READY".
+	State string
+	// Message without component and channelz prefixes.
+	Message string
+}
+
+// ParseGrpcLog message of gRPC internal logs into its component, channelz IDs and connectivity state.
+func ParseGrpcLog(msg string) GrpcLog {
+	parsed := GrpcLog{Message: msg}
+	if match := grpcComponentRegexp.FindStringSubmatch(parsed.Message); match != nil {
+		parsed.Component = match[1]
+		parsed.Message = parsed.Message[len(match[0]):]
+	}
+	if match := grpcEntityRegexp.FindStringSubmatch(parsed.Message); match != nil {
+		for _, id := range grpcIDRegexp.FindAllStringSubmatch(match[1], -1) {
+			n, _ := strconv.ParseInt(id[2], 10, 64)
+			switch strings.ToLower(id[1]) {
+			case "channel":
+				parsed.ChannelID = n
+			case "subchannel":
+				parsed.SubChannelID = n
+			}
+		}
+		parsed.Message = parsed.Message[len(match[0]):]
+	}
+	if match := grpcStateRegexp.FindStringSubmatch(parsed.Message); match != nil {
+		parsed.State = match[1]
+	}
+	return parsed
+}
+
+// LogGrpcLog fields of gRPC internal log, if assigned.
+//	{
+//		GrpcComponentField: "core",
+//		GrpcChannelField: 3,
+//		GrpcSubChannelField: 4,
+//		GrpcStateField: "READY",
+//	}
+func LogGrpcLog(logger *zerolog.Event, parsed GrpcLog) {
+	if parsed.Component != "" {
+		*logger = *logger.Str(GrpcComponentField, parsed.Component)
+	}
+	if parsed.ChannelID != 0 {
+		*logger = *logger.Int64(GrpcChannelField, parsed.ChannelID)
+	}
+	if parsed.SubChannelID != 0 {
+		*logger = *logger.Int64(GrpcSubChannelField, parsed.SubChannelID)
+	}
+	if parsed.State != "" {
+		*logger = *logger.Str(GrpcStateField, parsed.State)
+	}
+}
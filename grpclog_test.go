@@ -0,0 +1,40 @@
+package zerolog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GrpcLogSuite struct {
+	suite.Suite
+}
+
+func TestGrpcLog(t *testing.T) {
+	suite.Run(t, new(GrpcLogSuite))
+}
+
+func (s *GrpcLogSuite) TestParseGrpcLog() {
+	s.Equal(GrpcLog{
+		Component:    "core",
+		ChannelID:    3,
+		SubChannelID: 4,
+		State:        "READY",
+		Message:      "Subchannel Connectivity change to READY",
+	}, ParseGrpcLog("[core] [Channel #3 SubChannel #4] Subchannel Connectivity change to READY"))
+	s.Equal(GrpcLog{
+		Component: "core",
+		ChannelID: 1,
+		Message:   "Channel created",
+	}, ParseGrpcLog("[core] [Channel #1] Channel created"))
+	s.Equal(GrpcLog{
+		Component: "core",
+		State:     "CONNECTING",
+		Message:   "pickfirstBalancer: UpdateSubConnState: 0xc0001, {CONNECTING <nil>}; connectivity change to CONNECTING",
+	}, ParseGrpcLog("[core] pickfirstBalancer: UpdateSubConnState: 0xc0001, {CONNECTING <nil>}; connectivity change to CONNECTING"))
+}
+
+func (s *GrpcLogSuite) TestParseGrpcLogUnstructured() {
+	s.Equal(GrpcLog{Message: "WasHere"}, ParseGrpcLog("WasHere"))
+	s.Equal(GrpcLog{Message: "[not a component] message"}, ParseGrpcLog("[not a component] message"))
+}
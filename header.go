@@ -0,0 +1,114 @@
+package zerolog
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+)
+
+// LogOutgoingMetadata of headers and trailers set by gRPC handlers, if assigned.
+//	{
+//		HeaderField: {
+//			HeaderKey1: HeaderValue1,
+//		},
+//		TrailerField: {
+//			TrailerKey1: TrailerValue1,
+//		}
+//	}
+func LogOutgoingMetadata(e *zerolog.Event, header, trailer metadata.MD) {
+	if HeaderLog && len(header) > 0 {
+		*e = *e.Dict(HeaderField, LogMetadata(&header))
+	}
+	if TrailerLog && len(trailer) > 0 {
+		*e = *e.Dict(TrailerField, LogMetadata(&trailer))
+	}
+}
+
+// outgoingMetadata records headers and trailers set by gRPC handlers.
+type outgoingMetadata struct {
+	mu      sync.Mutex
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (o *outgoingMetadata) addHeader(md metadata.MD) {
+	o.mu.Lock()
+	o.header = metadata.Join(o.header, md)
+	o.mu.Unlock()
+}
+
+func (o *outgoingMetadata) addTrailer(md metadata.MD) {
+	o.mu.Lock()
+	o.trailer = metadata.Join(o.trailer, md)
+	o.mu.Unlock()
+}
+
+func (o *outgoingMetadata) log(e *zerolog.Event) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	LogOutgoingMetadata(e, o.header, o.trailer)
+}
+
+// StatsHandler is a gRPC Server Option that uses NewStatsHandler() to log headers and trailers of gRPC Requests.
+func StatsHandler() grpc.ServerOption {
+	return grpc.StatsHandler(NewStatsHandler())
+}
+
+// NewStatsHandler that records headers and trailers sent for gRPC Requests, which are sent after unary handlers
+// return. Unary interceptors then log calls once they end, instead of when handlers return without headers and
+// trailers.
+func NewStatsHandler() stats.Handler {
+	return statsHandler{}
+}
+
+// callStatsKey of callStats in gRPC call contexts.
+type callStatsKey struct{}
+
+// callStats records headers and trailers sent for a unary call, and logs it once the call ends.
+type callStats struct {
+	outgoingMetadata
+	end func()
+}
+
+// onEnd logs the call once it ends.
+func (c *callStats) onEnd(log func()) {
+	c.mu.Lock()
+	c.end = log
+	c.mu.Unlock()
+}
+
+type statsHandler struct{}
+
+func (statsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, callStatsKey{}, &callStats{})
+}
+
+func (statsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	call, ok := ctx.Value(callStatsKey{}).(*callStats)
+	if !ok || s.IsClient() {
+		return
+	}
+	switch s := s.(type) {
+	case *stats.OutHeader:
+		call.addHeader(s.Header)
+	case *stats.OutTrailer:
+		call.addTrailer(s.Trailer)
+	case *stats.End:
+		call.mu.Lock()
+		end := call.end
+		call.mu.Unlock()
+		if end != nil {
+			end()
+		}
+	}
+}
+
+func (statsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (statsHandler) HandleConn(context.Context, stats.ConnStats) {}
@@ -0,0 +1,161 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type MockServerTransportStream struct {
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *MockServerTransportStream) Method() string {
+	return "/TestService/TestUnary"
+}
+
+func (s *MockServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *MockServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *MockServerTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+type TestHeaderSuite struct {
+	suite.Suite
+	out    *bytes.Buffer
+	log    zerolog.Logger
+	stream *MockServerTransportStream
+	ctx    context.Context
+}
+
+func (s *TestHeaderSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	s.log = zerolog.New(s.out)
+	s.stream = &MockServerTransportStream{}
+	s.ctx = grpc.NewContextWithServerTransportStream(context.Background(), s.stream)
+	HeaderField = "header"
+	HeaderLog = true
+	TrailerField = "trailer"
+	TrailerLog = true
+	MetadataRedactlist = []string{"authorization"}
+}
+
+func TestHeader(t *testing.T) {
+	suite.Run(t, new(TestHeaderSuite))
+}
+
+func (s *TestHeaderSuite) TestUnaryServerInterceptorHeaders() {
+	handler := NewStatsHandler()
+	ctx := handler.TagRPC(s.ctx, &stats.RPCTagInfo{FullMethodName: "/TestService/TestUnary"})
+	interceptor := NewUnaryServerInterceptorWithLogger(&s.log)
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/TestService/TestUnary"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		s.NoError(grpc.SetHeader(ctx, metadata.Pairs("x-philip", "WasHere")))
+		s.NoError(grpc.SetTrailer(ctx, metadata.Pairs("authorization", "PhilipB")))
+		return nil, nil
+	})
+	s.NoError(err)
+	s.Equal(metadata.Pairs("x-philip", "WasHere"), s.stream.header, "Expected headers to be sent")
+	s.Equal(metadata.Pairs("authorization", "PhilipB"), s.stream.trailer, "Expected trailers to be sent")
+	s.Empty(s.out.String(), "Expected call logged once it ends")
+
+	handler.HandleRPC(ctx, &stats.OutHeader{Header: s.stream.header})
+	handler.HandleRPC(ctx, &stats.OutTrailer{Trailer: s.stream.trailer})
+	handler.HandleRPC(ctx, &stats.End{})
+	s.Contains(s.out.String(), `"grpc.kind":"unary"`)
+	s.Contains(s.out.String(), `"header":{"x-philip":"WasHere"}`)
+	s.Contains(s.out.String(), `"trailer":{"authorization":"[REDACTED]"}`)
+}
+
+func (s *TestHeaderSuite) TestUnaryServerInterceptorNoStatsHandler() {
+	interceptor := NewUnaryServerInterceptorWithLogger(&s.log)
+	_, err := interceptor(s.ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/TestService/TestUnary"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		s.NoError(grpc.SetHeader(ctx, metadata.Pairs("x-philip", "WasHere")))
+		return nil, nil
+	})
+	s.NoError(err)
+	s.Contains(s.out.String(), `"grpc.kind":"unary"`, "Expected call logged when handler returns")
+	s.NotContains(s.out.String(), `"header"`)
+}
+
+// headerServer sets headers and trailers, and checks handlers still access the gRPC transport stream.
+type headerServer struct {
+	s *TestHeaderSuite
+}
+
+func (h headerServer) TestUnary(ctx context.Context, req *pb.TestMessage) (*pb.TestMessage, error) {
+	h.s.NoError(grpc.SetHeader(ctx, metadata.Pairs("x-philip", "WasHere")))
+	h.s.NoError(grpc.SetTrailer(ctx, metadata.Pairs("x-trailer", "PhilipB")))
+	_, err := grpc.ClientSupportedCompressors(ctx)
+	h.s.NoError(err, "Expected transport stream of context unchanged")
+	return req, nil
+}
+
+// chanWriter sends every log to a channel.
+type chanWriter chan string
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	w <- string(p)
+	return len(p), nil
+}
+
+func (s *TestHeaderSuite) TestStatsHandler() {
+	out := make(chanWriter, 1)
+	log := zerolog.New(out)
+	server := grpc.NewServer(UnaryInterceptorWithLogger(&log), StatsHandler())
+	pb.RegisterTestServiceServer(server, headerServer{s})
+	lis := bufconn.Listen(1024 * 1024)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	defer conn.Close()
+	_, err = pb.NewTestServiceClient(conn).TestUnary(context.Background(), &pb.TestMessage{Test: "Hi"})
+	s.Require().NoError(err)
+	select {
+	case msg := <-out:
+		s.Contains(msg, `"header":{"x-philip":"WasHere"}`)
+		s.Contains(msg, `"trailer":{"x-trailer":"PhilipB"}`)
+	case <-time.After(5 * time.Second):
+		s.Fail("Expected call logged")
+	}
+}
+
+func (s *TestHeaderSuite) TestLogOutgoingMetadataDisabled() {
+	HeaderLog = false
+	TrailerLog = false
+	e := s.log.Info()
+	LogOutgoingMetadata(e, metadata.Pairs("x-philip", "WasHere"), metadata.Pairs("x-philip", "WasHere"))
+	e.Msg("")
+	s.JSONEq(`{"level":"info"}`, s.out.String())
+}
+
+func (s *TestHeaderSuite) TestLogOutgoingMetadataField() {
+	HeaderField = "philip"
+	e := s.log.Info()
+	LogOutgoingMetadata(e, metadata.Pairs("x-philip", "WasHere"), nil)
+	e.Msg("")
+	s.JSONEq(`{"level":"info","philip":{"x-philip":"WasHere"}}`, s.out.String())
+}
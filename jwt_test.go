@@ -0,0 +1,74 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+)
+
+type TestJWTSuite struct {
+	suite.Suite
+	out   *bytes.Buffer
+	log   *zerolog.Event
+	token string
+}
+
+func (s *TestJWTSuite) SetupSuite() {
+	s.token = "eyJhbGciOiJIUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"PhilipB","iss":"philip","aud":["a","b"],"exp":1,"scope":"read write"}`)) +
+		".c2lnbmF0dXJl"
+}
+
+func (s *TestJWTSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	JWTLog = true
+	MetadataLog = true
+	UserAgentLog = true
+	MetadataRedactlist = []string{"authorization"}
+}
+
+func (s *TestJWTSuite) TearDownTest() {
+	JWTLog = false
+}
+
+func TestJWT(t *testing.T) {
+	suite.Run(t, new(TestJWTSuite))
+}
+
+func (s *TestJWTSuite) TestLogIncomingMetadataJWTClaims() {
+	LogIncomingMetadata(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+s.token)), s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","md":{"authorization":"[REDACTED]"},"sub":"PhilipB","iss":"philip","aud":["a","b"],"scope":"read write"}`, s.out.String())
+	s.NotContains(s.out.String(), s.token)
+}
+
+func (s *TestJWTSuite) TestLogJWTClaimsDisabled() {
+	JWTLog = false
+	md := metadata.Pairs("authorization", "Bearer "+s.token)
+	LogJWTClaims(s.log, &md)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
+
+func (s *TestJWTSuite) TestLogJWTClaimsInvalid() {
+	md := metadata.Pairs("authorization", "Basic UGhpbGlwOkI=")
+	LogJWTClaims(s.log, &md)
+	md = metadata.MD{}
+	LogJWTClaims(s.log, &md)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
+
+func (s *TestJWTSuite) TestGetJWTClaims() {
+	s.Equal("PhilipB", GetJWTClaims(s.token)["sub"])
+	s.Equal("PhilipB", GetJWTClaims("bearer "+s.token)["sub"])
+	s.Nil(GetJWTClaims("a.b.c"))
+	s.Nil(GetJWTClaims("a.e30K!.c"))
+}
@@ -0,0 +1,46 @@
+package zerolog
+
+import (
+	"github.com/rs/zerolog"
+)
+
+// LogObject of key, using MarshalZerologObject generated by protoc-gen-go-zerolog if implemented,
+// or JSON otherwise.
+func LogObject(e *zerolog.Event, key string, i interface{}) {
+	if m, ok := i.(zerolog.LogObjectMarshaler); ok {
+		*e = *e.Object(key, m)
+	} else if b := GetRawJSON(i); b != nil {
+		*e = *e.RawJSON(key, b.Bytes())
+	} else {
+		*e = *e.Interface(key, i)
+	}
+}
+
+// LogArrayObject appends to arr, using MarshalZerologObject generated by protoc-gen-go-zerolog if
+// implemented.
+func LogArrayObject(arr *zerolog.Array, i interface{}) {
+	if m, ok := i.(zerolog.LogObjectMarshaler); ok {
+		arr.Object(m)
+	} else {
+		arr.Interface(i)
+	}
+}
+
+// TruncateString to n runes.
+func TruncateString(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// TruncateBytes to n bytes.
+func TruncateBytes(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
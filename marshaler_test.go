@@ -0,0 +1,75 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockMarshaler struct {
+	Test string
+}
+
+func (m MockMarshaler) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("generated", m.Test)
+}
+
+type TestMarshalerSuite struct {
+	suite.Suite
+	out *bytes.Buffer
+	log *zerolog.Event
+}
+
+func (s *TestMarshalerSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	ReqLog = true
+	RespLog = true
+}
+
+func TestMarshaler(t *testing.T) {
+	suite.Run(t, new(TestMarshalerSuite))
+}
+
+func (s *TestMarshalerSuite) TestLogRequestMarshaler() {
+	LogRequest(s.log, MockMarshaler{Test: "req"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","req":{"generated":"req"}}`, s.out.String())
+}
+
+func (s *TestMarshalerSuite) TestLogResponseMarshaler() {
+	LogResponse(s.log, MockMarshaler{Test: "resp"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","resp":{"generated":"resp"}}`, s.out.String())
+}
+
+func (s *TestMarshalerSuite) TestLogObject() {
+	LogObject(s.log, "marshaler", MockMarshaler{Test: "philip"})
+	LogObject(s.log, "proto", &pb.TestMessage{Test: "philip"})
+	LogObject(s.log, "other", []int{1})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","marshaler":{"generated":"philip"},"proto":{"test":"philip"},"other":[1]}`, s.out.String())
+}
+
+func (s *TestMarshalerSuite) TestLogArrayObject() {
+	arr := zerolog.Arr()
+	LogArrayObject(arr, MockMarshaler{Test: "philip"})
+	LogArrayObject(arr, 1)
+	s.log.Array("arr", arr).Msg("")
+	s.JSONEq(`{"level":"debug","arr":[{"generated":"philip"},1]}`, s.out.String())
+}
+
+func (s *TestMarshalerSuite) TestTruncateString() {
+	s.Equal("Phi", TruncateString("Philip", 3))
+	s.Equal("Philip", TruncateString("Philip", 10))
+	s.Equal("日本", TruncateString("日本語", 2))
+}
+
+func (s *TestMarshalerSuite) TestTruncateBytes() {
+	s.Equal([]byte("Phi"), TruncateBytes([]byte("Philip"), 3))
+	s.Equal([]byte("Philip"), TruncateBytes([]byte("Philip"), 10))
+}
@@ -0,0 +1,96 @@
+package zerolog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rs/zerolog"
+)
+
+// IsMetadataLogged if key matches MetadataAllowlist, when assigned, and does not match MetadataDenylist.
+func IsMetadataLogged(key string) bool {
+	if len(MetadataAllowlist) > 0 && !matchMetadataKey(MetadataAllowlist, key) {
+		return false
+	}
+	return !matchMetadataKey(MetadataDenylist, key)
+}
+
+// IsMetadataRedacted if key matches MetadataRedactlist.
+func IsMetadataRedacted(key string) bool {
+	return matchMetadataKey(MetadataRedactlist, key)
+}
+
+func matchMetadataKey(patterns []string, key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(key, pattern[:len(pattern)-1]) {
+				return true
+			}
+		} else if key == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	binaryMetadataMu sync.RWMutex
+	binaryMetadata   = map[string]proto.Message{}
+)
+
+// RegisterBinaryMetadata decodes binary metadata of key (e.g. "grpc-status-details-bin") as a Protobuf message of the
+// same type as m, to log as JSON instead of base64. A nil m unregisters key.
+func RegisterBinaryMetadata(key string, m proto.Message) {
+	binaryMetadataMu.Lock()
+	defer binaryMetadataMu.Unlock()
+	if m == nil {
+		delete(binaryMetadata, strings.ToLower(key))
+		return
+	}
+	binaryMetadata[strings.ToLower(key)] = m
+}
+
+// GetBinaryMetadataJSON decodes binary metadata of key to JSON, if registered with RegisterBinaryMetadata.
+func GetBinaryMetadataJSON(key string, v string) *bytes.Buffer {
+	binaryMetadataMu.RLock()
+	m, ok := binaryMetadata[key]
+	binaryMetadataMu.RUnlock()
+	if !ok {
+		return nil
+	}
+	pb := proto.MessageV1(proto.MessageV2(m).ProtoReflect().New().Interface())
+	if err := proto.Unmarshal([]byte(v), pb); err != nil {
+		return nil
+	}
+	return GetRawJSON(pb)
+}
+
+func isBinaryMetadata(key string) bool {
+	return strings.HasSuffix(key, "-bin")
+}
+
+func logMetadataValue(dict *zerolog.Event, key, v string) {
+	if !isBinaryMetadata(key) {
+		*dict = *dict.Str(key, v)
+	} else if b := GetBinaryMetadataJSON(key, v); b != nil {
+		*dict = *dict.RawJSON(key, b.Bytes())
+	} else {
+		*dict = *dict.Str(key, base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+}
+
+func logMetadataArrayValue(arr *zerolog.Array, key, v string) {
+	if !isBinaryMetadata(key) {
+		arr.Str(v)
+	} else if b := GetBinaryMetadataJSON(key, v); b != nil {
+		arr.Interface(json.RawMessage(b.Bytes()))
+	} else {
+		arr.Str(base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+}
@@ -0,0 +1,99 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+)
+
+type TestMetadataSuite struct {
+	suite.Suite
+	out        *bytes.Buffer
+	log        *zerolog.Event
+	md         metadata.MD
+	allowlist  []string
+	denylist   []string
+	redactlist []string
+	redacted   string
+}
+
+func (s *TestMetadataSuite) SetupTest() {
+	s.allowlist, s.denylist, s.redactlist, s.redacted = MetadataAllowlist, MetadataDenylist, MetadataRedactlist, RedactedValue
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	s.md = metadata.New(map[string]string{
+		"authorization": "Bearer PhilipB",
+		"x-api-key":     "PhilipB",
+		"x-request-id":  "1",
+		"x-philip":      "WasHere",
+	})
+	MetadataAllowlist = nil
+	MetadataDenylist = nil
+	MetadataRedactlist = []string{"authorization", "x-api-key"}
+	RedactedValue = "[REDACTED]"
+}
+
+func (s *TestMetadataSuite) TearDownTest() {
+	MetadataAllowlist, MetadataDenylist, MetadataRedactlist, RedactedValue = s.allowlist, s.denylist, s.redactlist, s.redacted
+}
+
+func TestMetadata(t *testing.T) {
+	suite.Run(t, new(TestMetadataSuite))
+}
+
+func (s *TestMetadataSuite) TestLogMetadataRedacted() {
+	s.log.Dict("md", LogMetadata(&s.md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"authorization":"[REDACTED]","x-api-key":"[REDACTED]","x-request-id":"1","x-philip":"WasHere"}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataAllowlist() {
+	MetadataAllowlist = []string{"x-*"}
+	s.log.Dict("md", LogMetadata(&s.md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"x-api-key":"[REDACTED]","x-request-id":"1","x-philip":"WasHere"}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataDenylist() {
+	MetadataDenylist = []string{"X-Philip", "authorization"}
+	s.log.Dict("md", LogMetadata(&s.md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"x-api-key":"[REDACTED]","x-request-id":"1"}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataRedactPrefix() {
+	MetadataRedactlist = []string{"x-*"}
+	RedactedValue = "***"
+	s.log.Dict("md", LogMetadata(&s.md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"authorization":"Bearer PhilipB","x-api-key":"***","x-request-id":"***","x-philip":"***"}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataMultipleValues() {
+	md := metadata.Pairs("x-philip", "Was", "x-philip", "Here")
+	s.log.Dict("md", LogMetadata(&md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"x-philip":["Was","Here"]}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataBinary() {
+	md := metadata.Pairs("x-philip-bin", "\x00\x01", "x-multi-bin", "\x00", "x-multi-bin", "\x01")
+	s.log.Dict("md", LogMetadata(&md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"x-philip-bin":"AAE=","x-multi-bin":["AA==","AQ=="]}}`, s.out.String())
+}
+
+func (s *TestMetadataSuite) TestLogMetadataBinaryRegistered() {
+	defer RegisterBinaryMetadata("x-test-bin", nil)
+	defer RegisterBinaryMetadata("x-tests-bin", nil)
+	RegisterBinaryMetadata("x-test-bin", &pb.TestMessage{})
+	b, err := proto.Marshal(&pb.TestMessage{Test: "Philip"})
+	s.NoError(err)
+	md := metadata.Pairs("x-test-bin", string(b), "x-tests-bin", string(b), "x-tests-bin", "\xff")
+	RegisterBinaryMetadata("X-Tests-Bin", &pb.TestMessage{})
+	s.log.Dict("md", LogMetadata(&md)).Msg("")
+	s.JSONEq(`{"level":"debug","md":{"x-test-bin":{"test":"Philip"},"x-tests-bin":[{"test":"Philip"},"/w=="]}}`, s.out.String())
+
+	RegisterBinaryMetadata("X-Test-Bin", nil)
+	s.Nil(GetBinaryMetadataJSON("x-test-bin", string(b)), "Expected unregistered key logged as base64")
+}
@@ -0,0 +1,57 @@
+package zerolog
+
+import (
+	"strings"
+)
+
+// MethodType of gRPC call.
+type MethodType string
+
+const (
+	// MethodTypeUnary of a single request and response.
+	MethodTypeUnary MethodType = "unary"
+	// MethodTypeClientStream of streamed requests and a single response.
+	MethodTypeClientStream MethodType = "client_stream"
+	// MethodTypeServerStream of a single request and streamed responses.
+	MethodTypeServerStream MethodType = "server_stream"
+	// MethodTypeBidiStream of streamed requests and responses.
+	MethodTypeBidiStream MethodType = "bidi_stream"
+)
+
+// GetMethodType of a gRPC call from its streaming flags.
+func GetMethodType(isClientStream, isServerStream bool) MethodType {
+	switch {
+	case isClientStream && isServerStream:
+		return MethodTypeBidiStream
+	case isClientStream:
+		return MethodTypeClientStream
+	case isServerStream:
+		return MethodTypeServerStream
+	default:
+		return MethodTypeUnary
+	}
+}
+
+// FullMethod of gRPC call, e.g. "/example.v1.ExampleService/ExampleMethod".
+type FullMethod struct {
+	// Package of Protobuf service, e.g. "example.v1".
+	Package string
+	// Service name, e.g. "ExampleService".
+	Service string
+	// Method name, e.g. "ExampleMethod".
+	Method string
+}
+
+// ParseFullMethod of gRPC call. Malformed methods have no package or service, and the method as is.
+func ParseFullMethod(fullMethod string) FullMethod {
+	name := strings.TrimPrefix(fullMethod, "/")
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return FullMethod{Method: name}
+	}
+	parsed := FullMethod{Service: name[:i], Method: name[i+1:]}
+	if j := strings.LastIndex(parsed.Service, "."); j >= 0 {
+		parsed.Package, parsed.Service = parsed.Service[:j], parsed.Service[j+1:]
+	}
+	return parsed
+}
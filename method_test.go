@@ -0,0 +1,52 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFullMethod(t *testing.T) {
+	for method, expected := range map[string]FullMethod{
+		"/example.v1.ExampleService/ExampleMethod": {Package: "example.v1", Service: "ExampleService", Method: "ExampleMethod"},
+		"/ExampleService/ExampleMethod":            {Service: "ExampleService", Method: "ExampleMethod"},
+		"/.ExampleService/ExampleMethod":           {Service: "ExampleService", Method: "ExampleMethod"},
+		"ExampleMethod":                            {Method: "ExampleMethod"},
+		"/":                                        {},
+		"":                                         {},
+	} {
+		assert.Equal(t, expected, ParseFullMethod(method), method)
+	}
+}
+
+func TestGetMethodType(t *testing.T) {
+	assert.Equal(t, MethodTypeUnary, GetMethodType(false, false))
+	assert.Equal(t, MethodTypeClientStream, GetMethodType(true, false))
+	assert.Equal(t, MethodTypeServerStream, GetMethodType(false, true))
+	assert.Equal(t, MethodTypeBidiStream, GetMethodType(true, true))
+}
+
+func TestLogFullMethod(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := zerolog.New(out)
+	e := logger.Debug()
+	LogPackage(e, "/example.ExampleService/ExampleMethod")
+	LogService(e, "/example.ExampleService/ExampleMethod")
+	LogMethod(e, "/example.ExampleService/ExampleMethod")
+	LogMethodType(e, MethodTypeServerStream)
+	e.Msg("")
+	assert.JSONEq(t, `{"level":"debug","package":"example","service":"ExampleService","method":"ExampleMethod","grpc.kind":"server_stream"}`, out.String())
+}
+
+func TestLogFullMethodMalformed(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := zerolog.New(out)
+	e := logger.Debug()
+	LogPackage(e, "")
+	LogService(e, "")
+	LogMethod(e, "")
+	e.Msg("")
+	assert.JSONEq(t, `{"level":"debug","method":""}`, out.String())
+}
@@ -0,0 +1,179 @@
+package zerolog
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// SetTrustedProxies from CIDRs (e.g. "10.0.0.0/8") or IPs.
+func SetTrustedProxies(cidrs ...string) error {
+	proxies := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		ipNet, err := ParseIPNet(cidr)
+		if err != nil {
+			return err
+		}
+		proxies = append(proxies, ipNet)
+	}
+	TrustedProxies = proxies
+	return nil
+}
+
+// ParseIPNet from a CIDR (e.g. "10.0.0.0/8") or an IP, as a network of the IP only.
+func ParseIPNet(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+			s += "/32"
+		} else {
+			s += "/128"
+		}
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	return ipNet, err
+}
+
+// IsTrustedProxy if ip is within TrustedProxies.
+func IsTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range TrustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClientIP of gRPC call from peer IP. Behind TrustedProxies, returns the last untrusted IP of X-Forwarded-For,
+// or X-Real-IP metadata.
+func GetClientIP(ctx context.Context, ip string) string {
+	if !IsTrustedProxy(ip) {
+		return ip
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ip
+	}
+	var forwarded []string
+	for _, v := range md.Get("x-forwarded-for") {
+		for _, f := range strings.Split(v, ",") {
+			if f = strings.TrimSpace(f); net.ParseIP(f) != nil {
+				forwarded = append(forwarded, f)
+			}
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if !IsTrustedProxy(forwarded[i]) || i == 0 {
+			return forwarded[i]
+		}
+	}
+	if realIP := md.Get("x-real-ip"); len(realIP) > 0 && net.ParseIP(strings.TrimSpace(realIP[0])) != nil {
+		return strings.TrimSpace(realIP[0])
+	}
+	return ip
+}
+
+// SplitHostPort of a peer address, returning the address as host if it has no port.
+func SplitHostPort(addr string) (string, string) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr, ""
+	}
+	return host, port
+}
+
+// LogTLS connection of gRPC client, if assigned, with the client certificate of mTLS callers. Verified is false for
+// certificates without a verified chain, e.g. with tls.RequestClientCert, or with tls.RequireAnyClientCert and
+// certificates verified by VerifyPeerCertificate (as with go-spiffe).
+//	{
+//		TLSField: {
+//			"version": "TLS 1.3",
+//			"cipher": "TLS_AES_128_GCM_SHA256",
+//			"alpn": "h2",
+//			"cn": "client.example.com",
+//			"san": ["client.example.com", "spiffe://example.com/client"],
+//			"spiffe_id": "spiffe://example.com/client",
+//			"verified": true,
+//		}
+//	}
+func LogTLS(ctx context.Context, logger *zerolog.Event) {
+	if !TLSLog {
+		return
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return
+	}
+	var state tls.ConnectionState
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		state = info.State
+	case *credentials.TLSInfo:
+		state = info.State
+	default:
+		return
+	}
+	dict := zerolog.Dict().Str("version", TLSVersionName(state.Version)).Str("cipher", tls.CipherSuiteName(state.CipherSuite))
+	if state.NegotiatedProtocol != "" {
+		dict = dict.Str("alpn", state.NegotiatedProtocol)
+	}
+	var cert *x509.Certificate
+	verified := len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0
+	if verified {
+		cert = state.VerifiedChains[0][0]
+	} else if len(state.PeerCertificates) > 0 {
+		cert = state.PeerCertificates[0]
+	}
+	if cert != nil {
+		if cert.Subject.CommonName != "" {
+			dict = dict.Str("cn", cert.Subject.CommonName)
+		}
+		var sans []string
+		sans = append(sans, cert.DNSNames...)
+		sans = append(sans, cert.EmailAddresses...)
+		for _, ip := range cert.IPAddresses {
+			sans = append(sans, ip.String())
+		}
+		spiffeID := ""
+		for _, uri := range cert.URIs {
+			sans = append(sans, uri.String())
+			if uri.Scheme == "spiffe" && spiffeID == "" {
+				spiffeID = uri.String()
+			}
+		}
+		if len(sans) > 0 {
+			dict = dict.Strs("san", sans)
+		}
+		if spiffeID != "" {
+			dict = dict.Str("spiffe_id", spiffeID)
+		}
+		dict = dict.Bool("verified", verified)
+	}
+	*logger = *logger.Dict(TLSField, dict)
+}
+
+// TLSVersionName of a TLS version, e.g. "TLS 1.3".
+func TLSVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return "unknown"
+	}
+}
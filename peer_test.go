@@ -0,0 +1,178 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type TestPeerSuite struct {
+	suite.Suite
+	out *bytes.Buffer
+	log *zerolog.Event
+	ctx context.Context
+}
+
+func (s *TestPeerSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	s.ctx = peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 8000},
+	})
+	IPField = "ip"
+	IPLog = true
+	PortField = "port"
+	TrustedProxies = nil
+	TLSField = "tls"
+	TLSLog = true
+}
+
+func (s *TestPeerSuite) TearDownTest() {
+	TrustedProxies = nil
+}
+
+func TestPeer(t *testing.T) {
+	suite.Run(t, new(TestPeerSuite))
+}
+
+func (s *TestPeerSuite) TestLogIPPort() {
+	LogIP(s.ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","ip":"10.0.0.1","port":"8000"}`, s.out.String())
+}
+
+func (s *TestPeerSuite) TestLogIPUntrustedProxy() {
+	ctx := metadata.NewIncomingContext(s.ctx, metadata.Pairs("x-forwarded-for", "1.1.1.1"))
+	LogIP(ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","ip":"10.0.0.1","port":"8000"}`, s.out.String())
+}
+
+func (s *TestPeerSuite) TestLogIPTrustedProxy() {
+	s.NoError(SetTrustedProxies("10.0.0.0/8"))
+	ctx := metadata.NewIncomingContext(s.ctx, metadata.Pairs("x-forwarded-for", "2.2.2.2, 1.1.1.1, 10.0.0.2"))
+	LogIP(ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","ip":"1.1.1.1"}`, s.out.String())
+}
+
+func (s *TestPeerSuite) TestSetTrustedProxies() {
+	s.NoError(SetTrustedProxies("10.0.0.1", "::1"))
+	s.True(IsTrustedProxy("10.0.0.1"))
+	s.False(IsTrustedProxy("10.0.0.2"))
+	s.True(IsTrustedProxy("::1"))
+	s.False(IsTrustedProxy("philip"))
+	s.Error(SetTrustedProxies("philip"))
+}
+
+func (s *TestPeerSuite) TestParseIPNet() {
+	ipNet, err := ParseIPNet("10.0.0.1")
+	s.NoError(err)
+	s.Equal("10.0.0.1/32", ipNet.String())
+	ipNet, err = ParseIPNet("::1")
+	s.NoError(err)
+	s.Equal("::1/128", ipNet.String())
+	ipNet, err = ParseIPNet("10.1.2.3/8")
+	s.NoError(err)
+	s.Equal("10.0.0.0/8", ipNet.String())
+	_, err = ParseIPNet("philip")
+	s.Error(err)
+}
+
+func (s *TestPeerSuite) TestGetClientIP() {
+	s.NoError(SetTrustedProxies("10.0.0.0/8"))
+	s.Equal("10.0.0.1", GetClientIP(s.ctx, "10.0.0.1"), "Expected peer IP without metadata")
+
+	ctx := metadata.NewIncomingContext(s.ctx, metadata.Pairs("x-real-ip", "1.1.1.1"))
+	s.Equal("1.1.1.1", GetClientIP(ctx, "10.0.0.1"))
+	s.Equal("2.2.2.2", GetClientIP(ctx, "2.2.2.2"), "Expected untrusted peer IP")
+
+	ctx = metadata.NewIncomingContext(s.ctx, metadata.Pairs("x-forwarded-for", "10.0.0.3", "x-forwarded-for", "10.0.0.2"))
+	s.Equal("10.0.0.3", GetClientIP(ctx, "10.0.0.1"), "Expected first IP when every proxy is trusted")
+
+	ctx = metadata.NewIncomingContext(s.ctx, metadata.Pairs("x-forwarded-for", "philip"))
+	s.Equal("10.0.0.1", GetClientIP(ctx, "10.0.0.1"))
+}
+
+func (s *TestPeerSuite) TestSplitHostPort() {
+	host, port := SplitHostPort("[::1]:8000")
+	s.Equal("::1", host)
+	s.Equal("8000", port)
+	host, port = SplitHostPort("127.0.0.1")
+	s.Equal("127.0.0.1", host)
+	s.Empty(port)
+}
+
+func (s *TestPeerSuite) TestLogTLS() {
+	spiffe, err := url.Parse("spiffe://example.com/philip")
+	s.NoError(err)
+	cert := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "philip"},
+		DNSNames:    []string{"philip.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+		URIs:        []*url.URL{spiffe},
+	}
+	LogTLS(peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				Version:            tls.VersionTLS12,
+				CipherSuite:        tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				NegotiatedProtocol: "h2",
+				PeerCertificates:   []*x509.Certificate{cert},
+				VerifiedChains:     [][]*x509.Certificate{{cert, {Subject: pkix.Name{CommonName: "ca"}}}},
+			},
+		},
+	}), s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","tls":{"version":"TLS 1.2","cipher":"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256","alpn":"h2",`+
+		`"cn":"philip","san":["philip.example.com","10.0.0.1","spiffe://example.com/philip"],"spiffe_id":"spiffe://example.com/philip","verified":true}}`, s.out.String())
+}
+
+func (s *TestPeerSuite) TestLogTLSUnverifiedCertificate() {
+	LogTLS(peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				Version:          tls.VersionTLS13,
+				CipherSuite:      tls.TLS_AES_128_GCM_SHA256,
+				PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "philip"}}},
+			},
+		},
+	}), s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","tls":{"version":"TLS 1.3","cipher":"TLS_AES_128_GCM_SHA256","cn":"philip","verified":false}}`,
+		s.out.String(), "Expected identity without a verified chain logged as unverified")
+}
+
+func (s *TestPeerSuite) TestLogTLSWithoutCertificate() {
+	LogTLS(peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: &credentials.TLSInfo{
+			State: tls.ConnectionState{
+				Version:     tls.VersionTLS13,
+				CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+			},
+		},
+	}), s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","tls":{"version":"TLS 1.3","cipher":"TLS_AES_128_GCM_SHA256"}}`, s.out.String())
+}
+
+func (s *TestPeerSuite) TestLogTLSInsecure() {
+	LogTLS(s.ctx, s.log)
+	LogTLS(context.Background(), s.log)
+	TLSLog = false
+	LogTLS(s.ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
@@ -0,0 +1,73 @@
+package zerolog
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+)
+
+// MethodPolicyDefault applies to gRPC methods without their own or their service's MethodPolicy.
+const MethodPolicyDefault = "*"
+
+var (
+	methodPoliciesMu sync.RWMutex
+	methodPolicies   = map[string]MethodPolicy{}
+)
+
+// MethodPolicy of logging gRPC calls, which can be changed at runtime.
+type MethodPolicy struct {
+	// Disabled logging of calls.
+	Disabled bool
+	// SampleRate of successful calls logged, between 0 and 1. Zero logs every call. Errors are always logged.
+	SampleRate float64
+	// OmitReq body, still logging its size.
+	OmitReq bool
+	// OmitResp body, still logging its size.
+	OmitResp bool
+}
+
+// SetMethodPolicy of a gRPC method (e.g. "/package.Service/Method"), service (e.g. "/package.Service/*") or
+// MethodPolicyDefault. A nil policy removes it.
+func SetMethodPolicy(method string, policy *MethodPolicy) {
+	methodPoliciesMu.Lock()
+	defer methodPoliciesMu.Unlock()
+	if policy == nil {
+		delete(methodPolicies, method)
+	} else {
+		methodPolicies[method] = *policy
+	}
+}
+
+// GetMethodPolicy of a gRPC method, falling back to its service's policy, then MethodPolicyDefault.
+func GetMethodPolicy(method string) MethodPolicy {
+	methodPoliciesMu.RLock()
+	defer methodPoliciesMu.RUnlock()
+	if policy, ok := methodPolicies[method]; ok {
+		return policy
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		if policy, ok := methodPolicies[method[:i+1]+"*"]; ok {
+			return policy
+		}
+	}
+	return methodPolicies[MethodPolicyDefault]
+}
+
+// GetMethodPolicies set, by method.
+func GetMethodPolicies() map[string]MethodPolicy {
+	methodPoliciesMu.RLock()
+	defer methodPoliciesMu.RUnlock()
+	policies := make(map[string]MethodPolicy, len(methodPolicies))
+	for method, policy := range methodPolicies {
+		policies[method] = policy
+	}
+	return policies
+}
+
+// Logged reports whether a call ending with err is logged, sampling successful calls.
+func (p MethodPolicy) Logged(err error) bool {
+	if p.Disabled {
+		return false
+	}
+	return err != nil || p.SampleRate <= 0 || p.SampleRate >= 1 || rand.Float64() < p.SampleRate
+}
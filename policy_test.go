@@ -0,0 +1,98 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+)
+
+type PolicySuite struct {
+	suite.Suite
+	out         *bytes.Buffer
+	log         zerolog.Logger
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	req         *pb.TestMessage
+}
+
+func TestPolicy(t *testing.T) {
+	suite.Run(t, new(PolicySuite))
+}
+
+func (s *PolicySuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	s.log = zerolog.New(s.out)
+	s.interceptor = NewUnaryServerInterceptorWithLogger(&s.log)
+	s.info = &grpc.UnaryServerInfo{FullMethod: "/TestService/TestUnary"}
+	s.req = &pb.TestMessage{Test: "PhilipWasHere"}
+	methodPolicies = map[string]MethodPolicy{}
+}
+
+func (s *PolicySuite) TearDownTest() {
+	methodPolicies = map[string]MethodPolicy{}
+}
+
+func (s *PolicySuite) call(err error) {
+	_, _ = s.interceptor(context.Background(), s.req, s.info, func(context.Context, interface{}) (interface{}, error) {
+		if err != nil {
+			return nil, err
+		}
+		return s.req, nil
+	})
+}
+
+func (s *PolicySuite) TestGetMethodPolicy() {
+	s.Equal(MethodPolicy{}, GetMethodPolicy("/example.ExampleService/ExampleMethod"))
+	SetMethodPolicy(MethodPolicyDefault, &MethodPolicy{OmitReq: true})
+	SetMethodPolicy("/example.ExampleService/*", &MethodPolicy{SampleRate: 0.5})
+	SetMethodPolicy("/example.ExampleService/ExampleMethod", &MethodPolicy{Disabled: true})
+	s.Equal(MethodPolicy{Disabled: true}, GetMethodPolicy("/example.ExampleService/ExampleMethod"))
+	s.Equal(MethodPolicy{SampleRate: 0.5}, GetMethodPolicy("/example.ExampleService/OtherMethod"))
+	s.Equal(MethodPolicy{OmitReq: true}, GetMethodPolicy("/example.OtherService/ExampleMethod"))
+	s.Len(GetMethodPolicies(), 3)
+
+	SetMethodPolicy("/example.ExampleService/ExampleMethod", nil)
+	s.Equal(MethodPolicy{SampleRate: 0.5}, GetMethodPolicy("/example.ExampleService/ExampleMethod"))
+}
+
+func (s *PolicySuite) TestLogged() {
+	s.True(MethodPolicy{}.Logged(nil))
+	s.False(MethodPolicy{Disabled: true}.Logged(errors.New("WasHere")))
+	s.True(MethodPolicy{SampleRate: 1}.Logged(nil))
+	s.True(MethodPolicy{SampleRate: 0.0001}.Logged(errors.New("WasHere")), "Expected errors always logged")
+	logged := 0
+	for i := 0; i < 1000; i++ {
+		if (MethodPolicy{SampleRate: 0.1}).Logged(nil) {
+			logged++
+		}
+	}
+	s.InDelta(100, logged, 60)
+}
+
+func (s *PolicySuite) TestDisabled() {
+	SetMethodPolicy(s.info.FullMethod, &MethodPolicy{Disabled: true})
+	s.call(nil)
+	s.call(errors.New("WasHere"))
+	s.Empty(s.out.String())
+}
+
+func (s *PolicySuite) TestOmitBodies() {
+	SetMethodPolicy(MethodPolicyDefault, &MethodPolicy{OmitReq: true, OmitResp: true})
+	s.call(nil)
+	s.NotContains(s.out.String(), `"req":`)
+	s.NotContains(s.out.String(), `"resp":`)
+	s.Contains(s.out.String(), `"req_size":15`)
+	s.Contains(s.out.String(), `"resp_size":15`)
+
+	s.out.Reset()
+	SetMethodPolicy(MethodPolicyDefault, &MethodPolicy{OmitResp: true})
+	s.call(nil)
+	s.Contains(s.out.String(), `"req":{"test":"PhilipWasHere"}`)
+	s.NotContains(s.out.String(), `"resp":`)
+}
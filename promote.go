@@ -0,0 +1,93 @@
+package zerolog
+
+import (
+	"encoding/base64"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	promotedFieldsMu sync.RWMutex
+	promotedFields   = map[string]map[string]string{}
+)
+
+// SetPromotedFields of a gRPC method (e.g. "/package.Service/Method") or Protobuf message (e.g. "package.Message"),
+// mapping request field paths (e.g. "account.region") to top-level log keys. Nil fields removes promotion.
+// Methods take precedence over messages. Repeated and map fields are not promoted.
+func SetPromotedFields(name string, fields map[string]string) {
+	promotedFieldsMu.Lock()
+	defer promotedFieldsMu.Unlock()
+	if fields == nil {
+		delete(promotedFields, name)
+	} else {
+		promotedFields[name] = fields
+	}
+}
+
+// LogPromotedFields of gRPC request, set for its method or message.
+//	{
+//		"customer_id": "PhilipB",
+//		"region": "au",
+//	}
+func LogPromotedFields(e *zerolog.Event, method string, req interface{}) {
+	pb, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	m := proto.MessageV2(pb).ProtoReflect()
+	promotedFieldsMu.RLock()
+	fields, ok := promotedFields[method]
+	if !ok {
+		fields, ok = promotedFields[string(m.Descriptor().FullName())]
+	}
+	promotedFieldsMu.RUnlock()
+	if !ok {
+		return
+	}
+	for path, key := range fields {
+		logPromotedField(e, m, strings.Split(path, "."), key)
+	}
+}
+
+func logPromotedField(e *zerolog.Event, m protoreflect.Message, path []string, key string) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !m.Has(fd) || fd.IsList() || fd.IsMap() {
+		return
+	}
+	v := m.Get(fd)
+	if len(path) > 1 {
+		if isMessageKind(fd) {
+			logPromotedField(e, v.Message(), path[1:], key)
+		}
+		return
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		*e = *e.Bool(key, v.Bool())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			*e = *e.Str(key, string(ev.Name()))
+		} else {
+			*e = *e.Int32(key, int32(v.Enum()))
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		*e = *e.Int64(key, v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		*e = *e.Uint64(key, v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		*e = *e.Float64(key, v.Float())
+	case protoreflect.StringKind:
+		*e = *e.Str(key, v.String())
+	case protoreflect.BytesKind:
+		*e = *e.Str(key, base64.StdEncoding.EncodeToString(v.Bytes()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if b := GetRawJSON(proto.MessageV1(v.Message().Interface())); b != nil {
+			*e = *e.RawJSON(key, b.Bytes())
+		}
+	}
+}
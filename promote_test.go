@@ -0,0 +1,90 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type TestPromoteSuite struct {
+	suite.Suite
+	out    *bytes.Buffer
+	log    *zerolog.Event
+	method string
+}
+
+func (s *TestPromoteSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	s.method = "/philip.Service/Method"
+}
+
+func (s *TestPromoteSuite) TearDownTest() {
+	SetPromotedFields(s.method, nil)
+	SetPromotedFields("TestMessage", nil)
+}
+
+func TestPromote(t *testing.T) {
+	suite.Run(t, new(TestPromoteSuite))
+}
+
+func (s *TestPromoteSuite) TestLogPromotedFieldsMethod() {
+	SetPromotedFields(s.method, map[string]string{
+		"name":                  "name",
+		"options.deprecated":    "deprecated",
+		"options.map_entry":     "unset",
+		"field":                 "repeated",
+		"philip":                "invalid",
+		"name.philip":           "scalar",
+		"reserved_range.start":  "list",
+		"options":               "options",
+		"options.deprecated.is": "deprecated_is",
+	})
+	LogPromotedFields(s.log, s.method, &descriptorpb.DescriptorProto{
+		Name: proto.String("Philip"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: proto.String("Was")},
+		},
+		Options: &descriptorpb.MessageOptions{
+			Deprecated: proto.Bool(true),
+		},
+	})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","name":"Philip","deprecated":true,"options":{"deprecated":true}}`, s.out.String())
+}
+
+func (s *TestPromoteSuite) TestLogPromotedFieldsMessage() {
+	SetPromotedFields("TestMessage", map[string]string{
+		"test": "promoted",
+	})
+	LogPromotedFields(s.log, s.method, &pb.TestMessage{Test: "Philip"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","promoted":"Philip"}`, s.out.String())
+}
+
+func (s *TestPromoteSuite) TestLogPromotedFieldsKinds() {
+	SetPromotedFields(s.method, map[string]string{
+		"number": "number",
+		"label":  "label",
+		"type":   "type",
+	})
+	LogPromotedFields(s.log, s.method, &descriptorpb.FieldDescriptorProto{
+		Number: proto.Int32(7),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+	})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","number":7,"label":"LABEL_REPEATED"}`, s.out.String())
+}
+
+func (s *TestPromoteSuite) TestLogPromotedFieldsUnset() {
+	LogPromotedFields(s.log, s.method, &pb.TestMessage{Test: "Philip"})
+	LogPromotedFields(s.log, s.method, new(interface{}))
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
@@ -0,0 +1,171 @@
+package zerolog
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	// GrpcRepeatedField key of times a gRPC internal log was suppressed by the rate limit.
+	GrpcRepeatedField = "repeated"
+	// GrpcRepeatedWindowField key of the rate limit window in seconds.
+	GrpcRepeatedWindowField = "repeated_window"
+	// GrpcRateLimitKeys of distinct messages before expired messages are pruned.
+	GrpcRateLimitKeys = 4096
+)
+
+// grpcRateLimiter of gRPC internal logs, shared by copies of a GrpcZeroLogger.
+type grpcRateLimiter struct {
+	mu     sync.Mutex
+	window time.Duration
+	burst  int
+	now    func() time.Time
+	after  func(d time.Duration, f func()) *time.Timer
+	msgs   map[grpcLogKey]*grpcLogCount
+	seq    uint64
+}
+
+type grpcLogKey struct {
+	level zerolog.Level
+	msg   string
+}
+
+type grpcLogCount struct {
+	level  zerolog.Level
+	parsed GrpcLog
+	start  time.Time
+	seq    uint64
+	n      int
+	timer  bool
+}
+
+func newGrpcRateLimiter(window time.Duration, burst int) *grpcRateLimiter {
+	return &grpcRateLimiter{
+		window: window,
+		burst:  burst,
+		now:    time.Now,
+		after:  time.AfterFunc,
+		msgs:   map[grpcLogKey]*grpcLogCount{},
+	}
+}
+
+// allow reports whether a message is logged, and the times it was suppressed in its previous window. When a message is
+// first suppressed, expire is called with its count at the end of its window, unless it recurs or is flushed before.
+func (r *grpcRateLimiter) allow(level zerolog.Level, parsed GrpcLog, expire func(grpcLogCount)) (int, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	key := grpcLogKey{level: level, msg: parsed.Message}
+	c, ok := r.msgs[key]
+	if !ok || now.Sub(c.start) >= r.window {
+		repeated := 0
+		if ok {
+			repeated = c.suppressed(r.burst)
+		} else if len(r.msgs) >= GrpcRateLimitKeys {
+			r.prune(now)
+		}
+		r.seq++
+		r.msgs[key] = &grpcLogCount{level: level, parsed: parsed, start: now, seq: r.seq, n: 1}
+		return repeated, true
+	}
+	c.n++
+	if c.n > r.burst && !c.timer {
+		c.timer = true
+		r.after(c.start.Add(r.window).Sub(now), func() {
+			if count, ok := r.expire(key, c); ok {
+				expire(count)
+			}
+		})
+	}
+	return 0, c.n <= r.burst
+}
+
+// expire a message at the end of its window, reporting its count if it has suppressed logs not yet logged.
+func (r *grpcRateLimiter) expire(key grpcLogKey, c *grpcLogCount) (grpcLogCount, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.msgs[key] != c {
+		return grpcLogCount{}, false
+	}
+	delete(r.msgs, key)
+	return *c, c.suppressed(r.burst) > 0
+}
+
+// prune expired messages without suppressed logs.
+func (r *grpcRateLimiter) prune(now time.Time) {
+	for key, c := range r.msgs {
+		if now.Sub(c.start) >= r.window && c.suppressed(r.burst) == 0 {
+			delete(r.msgs, key)
+		}
+	}
+}
+
+// flush messages with suppressed logs, oldest first.
+func (r *grpcRateLimiter) flush() []grpcLogCount {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var counts []grpcLogCount
+	for key, c := range r.msgs {
+		if c.suppressed(r.burst) > 0 {
+			counts = append(counts, *c)
+		}
+		delete(r.msgs, key)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].seq < counts[j].seq
+	})
+	return counts
+}
+
+func (c *grpcLogCount) suppressed(burst int) int {
+	if c.n > burst {
+		return c.n - burst
+	}
+	return 0
+}
+
+// WithRateLimit returns a copy of GrpcZeroLogger, logging each distinct gRPC internal message at most burst times per
+// window. Suppressed messages are counted and logged with the next occurrence of the message after its window, when
+// its window expires, or by Flush. A zero window disables rate limiting. Fatal logs are never rate limited.
+func (l GrpcZeroLogger) WithRateLimit(window time.Duration, burst int) GrpcZeroLogger {
+	l.limiter = nil
+	if window > 0 {
+		l.limiter = newGrpcRateLimiter(window, burst)
+	}
+	return l
+}
+
+// Flush logs gRPC internal messages suppressed by the rate limit, e.g. before exiting.
+func (l GrpcZeroLogger) Flush() {
+	if l.limiter == nil {
+		return
+	}
+	for _, c := range l.limiter.flush() {
+		l.repeated(c)
+	}
+}
+
+// repeated logs a gRPC internal message with the times it was suppressed by the rate limit.
+func (l GrpcZeroLogger) repeated(c grpcLogCount) {
+	e := l.log.WithLevel(c.level)
+	if e == nil {
+		return
+	}
+	LogGrpcLog(e, c.parsed)
+	LogRepeated(e, c.suppressed(l.limiter.burst), l.limiter.window)
+	e.Msg(c.parsed.Message)
+}
+
+// LogRepeated times a gRPC internal log was suppressed by the rate limit in its window, if any.
+//	{
+//		GrpcRepeatedField: 512,
+//		GrpcRepeatedWindowField: 60.00,
+//	}
+func LogRepeated(logger *zerolog.Event, repeated int, window time.Duration) {
+	if repeated > 0 {
+		*logger = *logger.Int(GrpcRepeatedField, repeated).Float64(GrpcRepeatedWindowField, window.Seconds())
+	}
+}
@@ -0,0 +1,189 @@
+package zerolog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitSuite struct {
+	suite.Suite
+	out     *bytes.Buffer
+	now     time.Time
+	expire  []func()
+	after   []time.Duration
+	grpclog GrpcZeroLogger
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
+
+func (s *RateLimitSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	s.now = time.Unix(0, 0)
+	s.grpclog = NewGrpcZeroLogger(zerolog.New(s.out)).WithRateLimit(time.Minute, 2)
+	s.grpclog.limiter.now = func() time.Time {
+		return s.now
+	}
+	s.expire = nil
+	s.after = nil
+	s.grpclog.limiter.after = func(d time.Duration, f func()) *time.Timer {
+		s.after = append(s.after, d)
+		s.expire = append(s.expire, f)
+		return nil
+	}
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+}
+
+func (s *RateLimitSuite) lines() []string {
+	defer s.out.Reset()
+	return strings.Split(strings.TrimSpace(s.out.String()), "\n")
+}
+
+func (s *RateLimitSuite) TestWithRateLimit() {
+	for i := 0; i < 514; i++ {
+		s.grpclog.Warning("[core] grpc: addrConn.createTransport failed to connect")
+	}
+	s.Len(s.lines(), 2)
+
+	s.now = s.now.Add(time.Minute)
+	s.grpclog.Warning("[core] grpc: addrConn.createTransport failed to connect")
+	s.JSONEq(`{"level":"warn","component":"core","repeated":512,"repeated_window":60,`+
+		`"message":"grpc: addrConn.createTransport failed to connect"}`, s.out.String())
+}
+
+func (s *RateLimitSuite) TestWithRateLimitDistinct() {
+	s.grpclog.Info("WasHere")
+	s.grpclog.Info("WasHere")
+	s.grpclog.Warning("WasHere")
+	s.grpclog.Info("PhilipWasHere")
+	s.grpclog.Info("WasHere")
+	s.Len(s.lines(), 4)
+}
+
+func (s *RateLimitSuite) TestFlush() {
+	for i := 0; i < 3; i++ {
+		s.grpclog.Info("WasHere")
+		s.grpclog.Error("PhilipWasHere")
+	}
+	s.grpclog.Info("Philip")
+	s.out.Reset()
+
+	s.grpclog.Flush()
+	lines := s.lines()
+	s.Require().Len(lines, 2)
+	s.JSONEq(`{"level":"info","repeated":1,"repeated_window":60,"message":"WasHere"}`, lines[0])
+	s.JSONEq(`{"level":"error","repeated":1,"repeated_window":60,"message":"PhilipWasHere"}`, lines[1])
+
+	s.grpclog.Flush()
+	s.Empty(s.out.String(), "Expected suppressed logs flushed once")
+	s.grpclog.Info("WasHere")
+	s.NotEmpty(s.out.String(), "Expected flushed messages reset")
+}
+
+func (s *RateLimitSuite) TestExpire() {
+	s.grpclog.Warning("[core] grpc: addrConn.createTransport failed to connect")
+	s.now = s.now.Add(15 * time.Second)
+	for i := 0; i < 4; i++ {
+		s.grpclog.Warning("[core] grpc: addrConn.createTransport failed to connect")
+	}
+	s.Equal([]time.Duration{45 * time.Second}, s.after, "Expected one timer at the end of the window")
+	s.out.Reset()
+
+	s.expire[0]()
+	s.JSONEq(`{"level":"warn","component":"core","repeated":3,"repeated_window":60,`+
+		`"message":"grpc: addrConn.createTransport failed to connect"}`, s.out.String())
+	s.out.Reset()
+	s.grpclog.Flush()
+	s.Empty(s.out.String(), "Expected expired logs not flushed again")
+}
+
+func (s *RateLimitSuite) TestExpireAfterFlush() {
+	for i := 0; i < 3; i++ {
+		s.grpclog.Info("WasHere")
+	}
+	s.grpclog.Flush()
+	s.out.Reset()
+	s.Require().Len(s.expire, 1)
+	s.expire[0]()
+	s.Empty(s.out.String(), "Expected flushed logs not logged again")
+}
+
+func (s *RateLimitSuite) TestDisabled() {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	for i := 0; i < 3; i++ {
+		s.grpclog.Info("[core] WasHere")
+	}
+	s.Empty(s.grpclog.limiter.msgs, "Expected disabled logs not rate limited")
+	s.Empty(s.after)
+}
+
+func (s *RateLimitSuite) TestFlushDisabled() {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	for i := 0; i < 3; i++ {
+		s.grpclog.Info("[core] WasHere")
+		s.grpclog.Info("[core] PhilipWasHere")
+	}
+	s.out.Reset()
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	s.Require().Len(s.expire, 2)
+	s.NotPanics(func() {
+		s.expire[0]()
+		s.grpclog.Flush()
+	})
+	s.Empty(s.out.String(), "Expected suppressed logs of disabled levels not logged")
+}
+
+func (s *RateLimitSuite) TestFatal() {
+	var msgs []string
+	logger := s.grpclog.WithFatalHandler(func(msg string) {
+		msgs = append(msgs, msg)
+	})
+	for i := 0; i < 3; i++ {
+		logger.Fatal("WasHere")
+	}
+	s.Len(msgs, 3, "Expected fatal logs not rate limited")
+}
+
+func (s *RateLimitSuite) TestFatalFlush() {
+	logger := s.grpclog.WithFatalHandler(func(string) {})
+	for i := 0; i < 3; i++ {
+		logger.Info("WasHere")
+	}
+	s.out.Reset()
+	logger.Fatal("Philip")
+	lines := s.lines()
+	s.Require().Len(lines, 2)
+	s.JSONEq(`{"level":"info","repeated":1,"repeated_window":60,"message":"WasHere"}`, lines[0])
+	s.JSONEq(`{"level":"fatal","message":"Philip"}`, lines[1])
+}
+
+func (s *RateLimitSuite) TestPrune() {
+	defer func(keys int) {
+		GrpcRateLimitKeys = keys
+	}(GrpcRateLimitKeys)
+	GrpcRateLimitKeys = 2
+	s.grpclog.Info("Philip")
+	s.grpclog.Info("Was")
+	s.grpclog.Info("Was")
+	s.grpclog.Info("Was")
+	s.now = s.now.Add(time.Minute)
+	s.grpclog.Info("Here")
+	s.Len(s.grpclog.limiter.msgs, 2, "Expected expired messages without suppressed logs pruned")
+}
+
+func (s *RateLimitSuite) TestWithoutRateLimit() {
+	s.grpclog = s.grpclog.WithRateLimit(0, 0)
+	for i := 0; i < 3; i++ {
+		s.grpclog.Info("WasHere")
+	}
+	s.Len(s.lines(), 3)
+	s.grpclog.Flush()
+	s.Empty(s.out.String())
+}
@@ -0,0 +1,112 @@
+package zerolog
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// StreamInterceptor is a gRPC Server Option that uses NewStreamServerInterceptor() to log gRPC Streams.
+func StreamInterceptor() grpc.ServerOption {
+	return grpc.StreamInterceptor(NewStreamServerInterceptor())
+}
+
+// StreamInterceptorWithLogger is a gRPC Server Option that uses NewStreamServerInterceptorWithLogger() to log gRPC Streams.
+func StreamInterceptorWithLogger(log *zerolog.Logger) grpc.ServerOption {
+	return grpc.StreamInterceptor(NewStreamServerInterceptorWithLogger(log))
+}
+
+// NewStreamServerInterceptor that logs gRPC Streams using Zerolog, once the stream ends.
+// The first message received is logged as the request.
+//	{
+//		PackageField: "example",
+//		ServiceField: "ExampleService",
+//		MethodField: "ExampleMethod",
+//		MethodTypeField: "bidi_stream",
+//		DurationField: 1.00
+//
+//		ReqField: {}, // JSON representation of first Request Protobuf
+//
+//		Err: "An unexpected error occurred",
+//		CodeField: "Unknown",
+//		MsgField: "Error message returned from the server",
+//		DetailsField: [Errors],
+//
+//		ZerologMessageField: "StreamMessageDefault",
+//	}
+func NewStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return NewStreamServerInterceptorWithLogger(&log.Logger)
+}
+
+// NewStreamServerInterceptorWithLogger that logs gRPC Streams using a Zerolog instance.
+func NewStreamServerInterceptorWithLogger(log *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		now := time.Now()
+		methodType := GetMethodType(info.IsClientStream, info.IsServerStream)
+		stream := &serverStream{ServerStream: ss}
+		err := handler(srv, stream)
+		logCall := func(logger *zerolog.Event) {
+			LogIncomingCall(ss.Context(), logger, info.FullMethod, now, stream.req)
+			LogMethodType(logger, methodType)
+			if err != nil {
+				LogStatusError(logger, err)
+			}
+			stream.log(logger)
+			logger.Msg(StreamMessageDefault)
+		}
+		if GetMethodPolicy(info.FullMethod).Logged(err) && log.Error().Enabled() {
+			if err != nil {
+				logCall(log.Error())
+			} else if log.Info().Enabled() {
+				logCall(log.Info())
+			}
+		}
+		PublishCall(ss.Context(), info.FullMethod, now, err, logCall)
+		return err
+	}
+}
+
+// serverStream records a copy of the first message received, and headers and trailers set by stream handlers.
+type serverStream struct {
+	grpc.ServerStream
+	outgoingMetadata
+	req interface{}
+}
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	err := s.ServerStream.SetHeader(md)
+	if err == nil {
+		s.addHeader(md)
+	}
+	return err
+}
+
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	err := s.ServerStream.SendHeader(md)
+	if err == nil {
+		s.addHeader(md)
+	}
+	return err
+}
+
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	s.ServerStream.SetTrailer(md)
+	s.addTrailer(md)
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		// Handlers may reuse or mutate messages after receiving them.
+		if msg, ok := m.(proto.Message); ok {
+			s.req = proto.Clone(msg)
+		} else {
+			s.req = m
+		}
+	}
+	return err
+}
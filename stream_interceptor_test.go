@@ -0,0 +1,135 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type MockServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pb.TestMessage
+}
+
+func (s *MockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *MockServerStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *MockServerStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *MockServerStream) SetTrailer(metadata.MD) {}
+
+func (s *MockServerStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	*m.(*pb.TestMessage) = *s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return nil
+}
+
+type TestStreamInterceptorSuite struct {
+	suite.Suite
+	out         *bytes.Buffer
+	log         zerolog.Logger
+	interceptor grpc.StreamServerInterceptor
+	info        *grpc.StreamServerInfo
+	stream      *MockServerStream
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	assert.NotNil(t, StreamInterceptor())
+	assert.NotNil(t, StreamInterceptorWithLogger(&zerolog.Logger{}))
+}
+
+func (s *TestStreamInterceptorSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	s.log = zerolog.New(s.out)
+	s.interceptor = NewStreamServerInterceptorWithLogger(&s.log)
+	s.info = &grpc.StreamServerInfo{
+		FullMethod:     "/TestService/TestStream",
+		IsClientStream: true,
+	}
+	s.stream = &MockServerStream{
+		ctx: context.Background(),
+		reqs: []*pb.TestMessage{
+			{Test: "first"},
+			{Test: "second"},
+		},
+	}
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	suite.Run(t, new(TestStreamInterceptorSuite))
+}
+
+func (s *TestStreamInterceptorSuite) handler(err error) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		for {
+			if recvErr := stream.RecvMsg(&pb.TestMessage{}); recvErr == io.EOF {
+				return err
+			}
+		}
+	}
+}
+
+func (s *TestStreamInterceptorSuite) TestStreamServerInterceptor() {
+	s.NoError(s.interceptor(nil, s.stream, s.info, s.handler(nil)))
+	s.Contains(s.out.String(), `"level":"info"`)
+	s.Contains(s.out.String(), `"req":{"test":"first"}`)
+	s.Contains(s.out.String(), `"grpc.kind":"client_stream"`)
+	s.Contains(s.out.String(), `"message":"stream"`)
+}
+
+func (s *TestStreamInterceptorSuite) TestStreamServerInterceptorReusedMessage() {
+	s.NoError(s.interceptor(nil, s.stream, s.info, func(srv interface{}, stream grpc.ServerStream) error {
+		req := &pb.TestMessage{}
+		for stream.RecvMsg(req) != io.EOF {
+			req.Test += "!"
+		}
+		return nil
+	}))
+	s.Contains(s.out.String(), `"req":{"test":"first"}`, "Expected first message logged before handler changes")
+}
+
+func (s *TestStreamInterceptorSuite) TestStreamServerInterceptorError() {
+	err := status.Error(codes.InvalidArgument, "Empty message")
+	s.Equal(err, s.interceptor(nil, s.stream, s.info, s.handler(err)))
+	s.Contains(s.out.String(), `"level":"error"`)
+	s.Contains(s.out.String(), `"code":"InvalidArgument"`)
+	s.Contains(s.out.String(), `"grpc.kind":"client_stream"`)
+}
+
+func (s *TestStreamInterceptorSuite) TestStreamServerInterceptorBidiStream() {
+	s.info.IsServerStream = true
+	s.NoError(s.interceptor(nil, s.stream, s.info, s.handler(nil)))
+	s.Contains(s.out.String(), `"grpc.kind":"bidi_stream"`)
+}
+
+func (s *TestStreamInterceptorSuite) TestStreamServerInterceptorHeaders() {
+	s.NoError(s.interceptor(nil, s.stream, s.info, func(srv interface{}, stream grpc.ServerStream) error {
+		s.NoError(stream.SetHeader(metadata.Pairs("x-philip", "Was")))
+		s.NoError(stream.SendHeader(metadata.Pairs("x-philip", "Here")))
+		stream.SetTrailer(metadata.Pairs("x-trailer", "PhilipB"))
+		return nil
+	}))
+	s.Contains(s.out.String(), `"header":{"x-philip":["Was","Here"]}`)
+	s.Contains(s.out.String(), `"trailer":{"x-trailer":"PhilipB"}`)
+}
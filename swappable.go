@@ -0,0 +1,171 @@
+package zerolog
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/grpclog"
+)
+
+// GrpcLogSetSwappableZeroLogger sets grpclog to a SwappableGrpcZeroLogger, which can be reconfigured at runtime.
+func GrpcLogSetSwappableZeroLogger(logger *SwappableGrpcZeroLogger) {
+	grpclog.SetLoggerV2(logger)
+}
+
+// SwappableGrpcZeroLogger delegates grpc log calls to a GrpcZeroLogger that can be atomically swapped at runtime,
+// without calling grpclog.SetLoggerV2 after gRPC initialization. It implements grpclog.DepthLoggerV2.
+type SwappableGrpcZeroLogger struct {
+	mu     sync.Mutex
+	logger atomic.Value
+}
+
+// NewSwappableGrpcZeroLogger creates a new SwappableGrpcZeroLogger delegating to logger.
+func NewSwappableGrpcZeroLogger(logger GrpcZeroLogger) *SwappableGrpcZeroLogger {
+	l := &SwappableGrpcZeroLogger{}
+	l.logger.Store(logger)
+	return l
+}
+
+// Load the GrpcZeroLogger delegated to.
+func (l *SwappableGrpcZeroLogger) Load() GrpcZeroLogger {
+	return l.logger.Load().(GrpcZeroLogger)
+}
+
+// Store a GrpcZeroLogger to delegate to.
+func (l *SwappableGrpcZeroLogger) Store(logger GrpcZeroLogger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logger.Store(logger)
+}
+
+// Swap the GrpcZeroLogger delegated to with the result of swap, atomically with other updates.
+func (l *SwappableGrpcZeroLogger) Swap(swap func(GrpcZeroLogger) GrpcZeroLogger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logger.Store(swap(l.Load()))
+}
+
+// SetLevel of gRPC internal logs.
+func (l *SwappableGrpcZeroLogger) SetLevel(level zerolog.Level) {
+	l.Swap(func(logger GrpcZeroLogger) GrpcZeroLogger {
+		logger.log = logger.log.Level(level)
+		return logger
+	})
+}
+
+// SetOutput of gRPC internal logs.
+func (l *SwappableGrpcZeroLogger) SetOutput(w io.Writer) {
+	l.Swap(func(logger GrpcZeroLogger) GrpcZeroLogger {
+		logger.log = logger.log.Output(w)
+		return logger
+	})
+}
+
+// SetVerbosity of gRPC verbose logs.
+func (l *SwappableGrpcZeroLogger) SetVerbosity(level int) {
+	l.Swap(func(logger GrpcZeroLogger) GrpcZeroLogger {
+		return logger.WithVerbosity(level)
+	})
+}
+
+// Fatal fatals arguments.
+func (l *SwappableGrpcZeroLogger) Fatal(args ...interface{}) {
+	l.Load().Fatal(args...)
+}
+
+// Fatalf fatals formatted string with arguments.
+func (l *SwappableGrpcZeroLogger) Fatalf(format string, args ...interface{}) {
+	l.Load().Fatalf(format, args...)
+}
+
+// Fatalln fatals and new line.
+func (l *SwappableGrpcZeroLogger) Fatalln(args ...interface{}) {
+	l.Load().Fatalln(args...)
+}
+
+// Error errors arguments.
+func (l *SwappableGrpcZeroLogger) Error(args ...interface{}) {
+	l.Load().Error(args...)
+}
+
+// Errorf errors formatted string with arguments.
+func (l *SwappableGrpcZeroLogger) Errorf(format string, args ...interface{}) {
+	l.Load().Errorf(format, args...)
+}
+
+// Errorln errors and new line.
+func (l *SwappableGrpcZeroLogger) Errorln(args ...interface{}) {
+	l.Load().Errorln(args...)
+}
+
+// Info infos arguments.
+func (l *SwappableGrpcZeroLogger) Info(args ...interface{}) {
+	l.Load().Info(args...)
+}
+
+// Infof infos formatted string with arguments.
+func (l *SwappableGrpcZeroLogger) Infof(format string, args ...interface{}) {
+	l.Load().Infof(format, args...)
+}
+
+// Infoln infos and new line.
+func (l *SwappableGrpcZeroLogger) Infoln(args ...interface{}) {
+	l.Load().Infoln(args...)
+}
+
+// Warning warns arguments.
+func (l *SwappableGrpcZeroLogger) Warning(args ...interface{}) {
+	l.Load().Warning(args...)
+}
+
+// Warningf warns formatted string with arguments.
+func (l *SwappableGrpcZeroLogger) Warningf(format string, args ...interface{}) {
+	l.Load().Warningf(format, args...)
+}
+
+// Warningln warns and new line.
+func (l *SwappableGrpcZeroLogger) Warningln(args ...interface{}) {
+	l.Load().Warningln(args...)
+}
+
+// Print logs arguments.
+func (l *SwappableGrpcZeroLogger) Print(args ...interface{}) {
+	l.Load().Print(args...)
+}
+
+// Printf logs formatted string with arguments.
+func (l *SwappableGrpcZeroLogger) Printf(format string, args ...interface{}) {
+	l.Load().Printf(format, args...)
+}
+
+// Println logs with new line.
+func (l *SwappableGrpcZeroLogger) Println(args ...interface{}) {
+	l.Load().Println(args...)
+}
+
+// InfoDepth infos arguments, with the caller depth frames above the caller of grpclog.InfoDepth.
+func (l *SwappableGrpcZeroLogger) InfoDepth(depth int, args ...interface{}) {
+	l.Load().InfoDepth(depth+1, args...)
+}
+
+// WarningDepth warns arguments, with the caller depth frames above the caller of grpclog.WarningDepth.
+func (l *SwappableGrpcZeroLogger) WarningDepth(depth int, args ...interface{}) {
+	l.Load().WarningDepth(depth+1, args...)
+}
+
+// ErrorDepth errors arguments, with the caller depth frames above the caller of grpclog.ErrorDepth.
+func (l *SwappableGrpcZeroLogger) ErrorDepth(depth int, args ...interface{}) {
+	l.Load().ErrorDepth(depth+1, args...)
+}
+
+// FatalDepth fatals arguments, with the caller depth frames above the caller of grpclog.FatalDepth.
+func (l *SwappableGrpcZeroLogger) FatalDepth(depth int, args ...interface{}) {
+	l.Load().FatalDepth(depth+1, args...)
+}
+
+// V reports whether gRPC verbose logs of level are enabled.
+func (l *SwappableGrpcZeroLogger) V(level int) bool {
+	return l.Load().V(level)
+}
@@ -0,0 +1,109 @@
+package zerolog
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/grpclog"
+)
+
+type SwappableSuite struct {
+	suite.Suite
+	out     *bytes.Buffer
+	grpclog *SwappableGrpcZeroLogger
+}
+
+func TestSwappable(t *testing.T) {
+	suite.Run(t, new(SwappableSuite))
+}
+
+func (s *SwappableSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	s.grpclog = NewSwappableGrpcZeroLogger(NewGrpcZeroLogger(zerolog.New(s.out)))
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+}
+
+func TestGrpcLogSetSwappableZeroLogger(t *testing.T) {
+	defer GrpcLogSetNewZeroLogger()
+	logger := NewSwappableGrpcZeroLogger(NewGrpcZeroLogger(zerolog.Logger{}))
+	GrpcLogSetSwappableZeroLogger(logger)
+	logger.SetVerbosity(2)
+	assert.True(t, grpclog.V(2))
+}
+
+func (s *SwappableSuite) TestSetLevel() {
+	s.grpclog.Info("WasHere")
+	s.JSONEq(`{"level":"info","message":"WasHere"}`, s.out.String())
+
+	s.out.Reset()
+	s.grpclog.SetLevel(zerolog.WarnLevel)
+	s.grpclog.Info("WasHere")
+	s.Empty(s.out.String())
+	s.False(s.grpclog.V(0))
+	s.NotPanics(func() {
+		s.grpclog.Info("[core] [Channel #1] Channel Connectivity change to READY")
+	})
+	s.Empty(s.out.String(), "Expected component info logs filtered")
+	s.grpclog.Warningf("Philip%v", "WasHere")
+	s.JSONEq(`{"level":"warn","message":"PhilipWasHere"}`, s.out.String())
+
+	s.out.Reset()
+	s.grpclog.SetLevel(zerolog.InfoLevel)
+	s.grpclog.Infoln("WasHere")
+	s.JSONEq(`{"level":"info","message":"WasHere"}`, s.out.String())
+}
+
+func (s *SwappableSuite) TestSetOutput() {
+	out := &bytes.Buffer{}
+	s.grpclog.SetOutput(out)
+	s.grpclog.Error("WasHere")
+	s.Empty(s.out.String())
+	s.JSONEq(`{"level":"error","message":"WasHere"}`, out.String())
+}
+
+func (s *SwappableSuite) TestSetVerbosity() {
+	s.False(s.grpclog.V(2))
+	s.grpclog.SetVerbosity(2)
+	s.True(s.grpclog.V(2))
+	s.Equal(2, s.grpclog.Load().verbosity)
+}
+
+func (s *SwappableSuite) TestStore() {
+	out := &bytes.Buffer{}
+	s.grpclog.Store(NewGrpcZeroLogger(zerolog.New(out)).WithComponentLevel("core", zerolog.ErrorLevel))
+	s.grpclog.Warning("[core] WasHere")
+	s.grpclog.Print("WasHere")
+	s.JSONEq(`{"level":"info","message":"WasHere"}`, out.String())
+}
+
+func (s *SwappableSuite) TestDepth() {
+	_, file, line, _ := runtime.Caller(0)
+	grpclogDepthCall(s.grpclog.WarningDepth, 0, "WasHere")
+	s.JSONEq(fmt.Sprintf(`{"level":"warn","caller":"%v:%v","message":"WasHere"}`, file, line+1), s.out.String())
+}
+
+func (s *SwappableSuite) TestConcurrent() {
+	s.grpclog.SetOutput(zerolog.SyncWriter(s.out))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s.grpclog.SetLevel(zerolog.ErrorLevel)
+			s.grpclog.SetVerbosity(1)
+		}()
+		go func() {
+			defer wg.Done()
+			s.grpclog.V(1)
+			s.grpclog.Errorf("WasHere")
+		}()
+	}
+	wg.Wait()
+	s.Equal(zerolog.ErrorLevel, s.grpclog.Load().log.GetLevel())
+}
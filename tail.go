@@ -0,0 +1,97 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	callSubscribersMu sync.RWMutex
+	callSubscribers   = map[chan CallEvent]*uint64{}
+)
+
+// CallEvent of a gRPC call ended in the interceptors, published to subscribers of SubscribeCalls.
+type CallEvent struct {
+	// Time the call ended.
+	Time time.Time
+	// FullMethod of the call, e.g. "/package.Service/Method".
+	FullMethod string
+	// Code of the call status.
+	Code string
+	// Duration of the call.
+	Duration time.Duration
+	// Peer IP of the client, if assigned.
+	Peer string
+	// Log of the call in JSON, as logged by the interceptors without the fields of their Zerolog logger.
+	Log []byte
+	// Dropped events since the previous event, while the subscriber buffer was full.
+	Dropped uint64
+}
+
+// SubscribeCalls ended in the interceptors, buffering up to buffer events. Events are dropped while the buffer is full.
+// Cancel unsubscribes and closes the events channel.
+func SubscribeCalls(buffer int) (<-chan CallEvent, func()) {
+	events := make(chan CallEvent, buffer)
+	callSubscribersMu.Lock()
+	callSubscribers[events] = new(uint64)
+	callSubscribersMu.Unlock()
+	var once sync.Once
+	return events, func() {
+		once.Do(func() {
+			callSubscribersMu.Lock()
+			delete(callSubscribers, events)
+			callSubscribersMu.Unlock()
+			close(events)
+		})
+	}
+}
+
+// PublishCall of gRPC method started at start and ended with err to subscribers, if any, logging it with log.
+// Calls are published regardless of their MethodPolicy, besides omitted bodies.
+func PublishCall(ctx context.Context, method string, start time.Time, err error, log func(*zerolog.Event)) {
+	callSubscribersMu.RLock()
+	subscribers := len(callSubscribers)
+	callSubscribersMu.RUnlock()
+	if subscribers == 0 {
+		return
+	}
+	event := CallEvent{
+		Time:       time.Now(),
+		FullMethod: method,
+		Code:       status.Code(err).String(),
+		Duration:   time.Since(start),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip, _ := SplitHostPort(p.Addr.String())
+		event.Peer = GetClientIP(ctx, ip)
+	}
+	level := zerolog.InfoLevel
+	if err != nil {
+		level = zerolog.ErrorLevel
+	}
+	b := &bytes.Buffer{}
+	l := zerolog.New(b)
+	// Events without a level are logged regardless of the global level, unless it is disabled.
+	if e := l.Log(); e != nil {
+		log(e.Str(zerolog.LevelFieldName, zerolog.LevelFieldMarshalFunc(level)))
+		event.Log = bytes.TrimSpace(b.Bytes())
+	}
+	callSubscribersMu.RLock()
+	defer callSubscribersMu.RUnlock()
+	for events, dropped := range callSubscribers {
+		event.Dropped = atomic.LoadUint64(dropped)
+		select {
+		case events <- event:
+			atomic.AddUint64(dropped, -event.Dropped)
+		default:
+			atomic.AddUint64(dropped, 1)
+		}
+	}
+}
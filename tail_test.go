@@ -0,0 +1,112 @@
+package zerolog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type TailSuite struct {
+	suite.Suite
+	ctx         context.Context
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+}
+
+func TestTail(t *testing.T) {
+	suite.Run(t, new(TailSuite))
+}
+
+func (s *TailSuite) SetupTest() {
+	log := zerolog.Nop()
+	s.interceptor = NewUnaryServerInterceptorWithLogger(&log)
+	s.info = &grpc.UnaryServerInfo{FullMethod: "/TestService/TestUnary"}
+	s.ctx = peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 8000},
+	})
+	methodPolicies = map[string]MethodPolicy{}
+}
+
+func (s *TailSuite) call(err error) {
+	_, _ = s.interceptor(s.ctx, &pb.TestMessage{Test: "PhilipWasHere"}, s.info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err != nil {
+				return nil, err
+			}
+			return req, nil
+		})
+}
+
+func (s *TailSuite) TestSubscribeCalls() {
+	events, cancel := SubscribeCalls(2)
+	defer cancel()
+	SetMethodPolicy(s.info.FullMethod, &MethodPolicy{Disabled: true, OmitResp: true})
+	s.call(nil)
+	s.call(status.Error(codes.NotFound, "WasHere"))
+
+	event := <-events
+	s.Equal("/TestService/TestUnary", event.FullMethod)
+	s.Equal("OK", event.Code)
+	s.Equal("10.0.0.1", event.Peer)
+	s.NotZero(event.Time)
+	log := map[string]interface{}{}
+	s.Require().NoError(json.Unmarshal(event.Log, &log))
+	s.Equal("info", log["level"])
+	s.Equal(map[string]interface{}{"test": "PhilipWasHere"}, log["req"])
+	s.NotContains(log, "resp", "Expected omitted bodies not published")
+	s.Equal("unary", log["message"])
+
+	event = <-events
+	s.Equal("NotFound", event.Code)
+	s.Contains(string(event.Log), `"level":"error"`)
+}
+
+func (s *TailSuite) TestSubscribeCallsGlobalLevel() {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	events, cancel := SubscribeCalls(1)
+	defer cancel()
+	s.NotPanics(func() {
+		s.call(nil)
+	})
+	event := <-events
+	s.Equal("OK", event.Code)
+	s.Contains(string(event.Log), `"level":"info"`, "Expected calls published above the global level")
+}
+
+func (s *TailSuite) TestSubscribeCallsDropped() {
+	events, cancel := SubscribeCalls(1)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		s.call(nil)
+	}
+	s.Zero((<-events).Dropped)
+	s.call(errors.New("WasHere"))
+	s.Equal(uint64(2), (<-events).Dropped)
+}
+
+func (s *TailSuite) TestCancel() {
+	events, cancel := SubscribeCalls(1)
+	cancel()
+	cancel()
+	s.call(nil)
+	_, ok := <-events
+	s.False(ok, "Expected events closed")
+}
+
+func (s *TailSuite) TestPublishCallWithoutSubscribers() {
+	PublishCall(s.ctx, s.info.FullMethod, time.Now(), nil, func(*zerolog.Event) {
+		s.Fail("Expected calls not logged without subscribers")
+	})
+}
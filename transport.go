@@ -0,0 +1,52 @@
+package zerolog
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LogTransport compression, authority and content-subtype of gRPC call, if assigned.
+//	{
+//		CompressionField: "gzip",
+//		AcceptEncodingField: ["gzip", "identity"],
+//		AuthorityField: "example.com:443",
+//		ContentSubtypeField: "proto",
+//	}
+func LogTransport(ctx context.Context, logger *zerolog.Event) {
+	stream := grpc.ServerTransportStreamFromContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	if CompressionLog {
+		if s, ok := stream.(interface{ RecvCompress() string }); ok && s.RecvCompress() != "" {
+			*logger = *logger.Str(CompressionField, s.RecvCompress())
+		}
+		if accept := md.Get("grpc-accept-encoding"); len(accept) > 0 {
+			var encodings []string
+			for _, v := range accept {
+				for _, encoding := range strings.Split(v, ",") {
+					if encoding = strings.TrimSpace(encoding); encoding != "" {
+						encodings = append(encodings, encoding)
+					}
+				}
+			}
+			*logger = *logger.Strs(AcceptEncodingField, encodings)
+		}
+	}
+	if AuthorityLog {
+		if authority := md.Get(":authority"); len(authority) > 0 {
+			*logger = *logger.Str(AuthorityField, authority[0])
+		}
+	}
+	if ContentSubtypeLog {
+		if s, ok := stream.(interface{ ContentSubtype() string }); ok {
+			subtype := s.ContentSubtype()
+			if subtype == "" {
+				subtype = "proto"
+			}
+			*logger = *logger.Str(ContentSubtypeField, subtype)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type MockTransportStream struct {
+	MockServerTransportStream
+	compress string
+	subtype  string
+}
+
+func (s *MockTransportStream) RecvCompress() string {
+	return s.compress
+}
+
+func (s *MockTransportStream) ContentSubtype() string {
+	return s.subtype
+}
+
+type TestTransportSuite struct {
+	suite.Suite
+	out *bytes.Buffer
+	log *zerolog.Event
+	ctx context.Context
+}
+
+func (s *TestTransportSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	s.ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		":authority", "philip.example.com:443",
+		"grpc-accept-encoding", "gzip, identity",
+	))
+	CompressionLog = true
+	AuthorityLog = true
+	ContentSubtypeLog = true
+}
+
+func TestTransport(t *testing.T) {
+	suite.Run(t, new(TestTransportSuite))
+}
+
+func (s *TestTransportSuite) TestLogTransport() {
+	ctx := grpc.NewContextWithServerTransportStream(s.ctx, &MockTransportStream{compress: "gzip", subtype: "json"})
+	LogTransport(ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","grpc.encoding":"gzip","grpc.accept_encoding":["gzip","identity"],`+
+		`"grpc.authority":"philip.example.com:443","grpc.content_subtype":"json"}`, s.out.String())
+}
+
+func (s *TestTransportSuite) TestLogTransportDefaults() {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &MockTransportStream{})
+	LogTransport(ctx, s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","grpc.content_subtype":"proto"}`, s.out.String())
+}
+
+func (s *TestTransportSuite) TestLogTransportDisabled() {
+	CompressionLog = false
+	AuthorityLog = false
+	ContentSubtypeLog = false
+	ctx := grpc.NewContextWithServerTransportStream(s.ctx, &MockTransportStream{compress: "gzip", subtype: "json"})
+	LogTransport(ctx, s.log)
+	LogTransport(context.Background(), s.log)
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
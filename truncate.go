@@ -0,0 +1,144 @@
+package zerolog
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LogTruncated body of gRPC Call when its JSON is at least MaxSize, marking the original size and
+// lengths of truncated repeated fields. Bodies still at least MaxSize when truncated are not logged, and
+// marked "dropped": true.
+//	{
+//		ReqField: {},
+//		ReqField+TruncatedSuffix: {
+//			"size": 4096000,
+//			"fields": {
+//				"items": 512,
+//			}
+//		}
+//	}
+func LogTruncated(e *zerolog.Event, field string, pb proto.Message, size int) {
+	truncated, fields := Truncate(pb)
+	b := GetRawJSON(truncated)
+	if b != nil {
+		*e = *e.RawJSON(field, b.Bytes())
+	}
+	dict := zerolog.Dict().Int("size", size)
+	if len(fields) > 0 {
+		paths := make([]string, 0, len(fields))
+		for path := range fields {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		counts := zerolog.Dict()
+		for _, path := range paths {
+			counts = counts.Int(path, fields[path])
+		}
+		dict = dict.Dict("fields", counts)
+	}
+	if b == nil {
+		dict = dict.Bool("dropped", true)
+	}
+	*e = *e.Dict(field+TruncatedSuffix, dict)
+}
+
+// Truncate a copy of a Protobuf message, limiting strings to TruncateStringLen, bytes to TruncateBytesLen,
+// repeated and map fields to TruncateRepeatedLen and nested messages to TruncateDepth. Returns the
+// original lengths of truncated repeated and map fields by JSON path.
+func Truncate(pb proto.Message) (proto.Message, map[string]int) {
+	m := proto.MessageV2(proto.Clone(pb))
+	fields := map[string]int{}
+	truncateMessage(m.ProtoReflect(), "", 1, fields)
+	return proto.MessageV1(m), fields
+}
+
+func truncateMessage(m protoreflect.Message, path string, depth int, fields map[string]int) {
+	var fds []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	for _, fd := range fds {
+		name := fd.JSONName()
+		if path != "" {
+			name = path + "." + name
+		}
+		switch {
+		case fd.IsList():
+			truncateList(m.Mutable(fd).List(), fd, name, depth, fields)
+		case fd.IsMap():
+			truncateMap(m.Mutable(fd).Map(), fd.MapValue(), name, depth, fields)
+		case isMessageKind(fd):
+			if TruncateDepth > 0 && depth >= TruncateDepth {
+				m.Clear(fd)
+			} else {
+				truncateMessage(m.Mutable(fd).Message(), name, depth+1, fields)
+			}
+		default:
+			m.Set(fd, truncateValue(fd, m.Get(fd)))
+		}
+	}
+}
+
+func truncateList(l protoreflect.List, fd protoreflect.FieldDescriptor, path string, depth int, fields map[string]int) {
+	if TruncateRepeatedLen > 0 && l.Len() > TruncateRepeatedLen {
+		fields[path] = l.Len()
+		l.Truncate(TruncateRepeatedLen)
+	}
+	if isMessageKind(fd) && TruncateDepth > 0 && depth >= TruncateDepth {
+		l.Truncate(0)
+		return
+	}
+	for i := 0; i < l.Len(); i++ {
+		if isMessageKind(fd) {
+			truncateMessage(l.Get(i).Message(), path, depth+1, fields)
+		} else {
+			l.Set(i, truncateValue(fd, l.Get(i)))
+		}
+	}
+}
+
+func truncateMap(mp protoreflect.Map, fd protoreflect.FieldDescriptor, path string, depth int, fields map[string]int) {
+	var keys []protoreflect.MapKey
+	mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	if TruncateRepeatedLen > 0 && len(keys) > TruncateRepeatedLen {
+		fields[path] = len(keys)
+		for _, k := range keys[TruncateRepeatedLen:] {
+			mp.Clear(k)
+		}
+		keys = keys[:TruncateRepeatedLen]
+	}
+	for _, k := range keys {
+		if !isMessageKind(fd) {
+			mp.Set(k, truncateValue(fd, mp.Get(k)))
+		} else if TruncateDepth > 0 && depth >= TruncateDepth {
+			mp.Clear(k)
+		} else {
+			truncateMessage(mp.Get(k).Message(), path, depth+1, fields)
+		}
+	}
+}
+
+func truncateValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if TruncateStringLen > 0 {
+			return protoreflect.ValueOfString(TruncateString(v.String(), TruncateStringLen))
+		}
+	case protoreflect.BytesKind:
+		if TruncateBytesLen > 0 {
+			return protoreflect.ValueOfBytes(TruncateBytes(v.Bytes(), TruncateBytesLen))
+		}
+	}
+	return v
+}
+
+func isMessageKind(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
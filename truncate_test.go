@@ -0,0 +1,127 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/philip-bui/grpc-zerolog/protos"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type TestTruncateSuite struct {
+	suite.Suite
+	out *bytes.Buffer
+	log *zerolog.Event
+}
+
+func (s *TestTruncateSuite) SetupTest() {
+	s.out = &bytes.Buffer{}
+	logger := zerolog.New(s.out)
+	s.log = logger.Debug()
+	ReqField = "req"
+	ReqLog = true
+	MaxSize = 2048000
+	TruncateLog = true
+	TruncatedSuffix = "_truncated"
+	TruncateStringLen = 1024
+	TruncateBytesLen = 256
+	TruncateRepeatedLen = 16
+	TruncateDepth = 8
+}
+
+func (s *TestTruncateSuite) TearDownSuite() {
+	MaxSize = 2048000
+}
+
+func TestTruncate(t *testing.T) {
+	suite.Run(t, new(TestTruncateSuite))
+}
+
+func (s *TestTruncateSuite) TestLogRequestTruncated() {
+	MaxSize = 20
+	TruncateStringLen = 3
+	LogRequest(s.log, &pb.TestMessage{Test: "PhilipWasHere"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","req":{"test":"Phi"},"req_truncated":{"size":24}}`, s.out.String())
+}
+
+func (s *TestTruncateSuite) TestLogRequestTruncatedDropped() {
+	MaxSize = 10
+	TruncateRepeatedLen = 1
+	LogRequest(s.log, &descriptorpb.DescriptorProto{
+		Field:      []*descriptorpb.FieldDescriptorProto{{Name: proto.String("a")}, {Name: proto.String("b")}},
+		Extension:  []*descriptorpb.FieldDescriptorProto{{Name: proto.String("c")}, {Name: proto.String("d")}},
+		NestedType: []*descriptorpb.DescriptorProto{{Name: proto.String("e")}, {Name: proto.String("f")}},
+	})
+	s.log.Msg("")
+	s.Equal(`{"level":"debug","req_truncated":{"size":118,"fields":{"extension":2,"field":2,"nestedType":2},`+
+		`"dropped":true}}`+"\n", s.out.String(), "Expected sorted fields and dropped body marked")
+}
+
+func (s *TestTruncateSuite) TestLogRequestTruncatedDisabled() {
+	MaxSize = 10
+	TruncateLog = false
+	LogRequest(s.log, &pb.TestMessage{Test: "PhilipWasHere"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug"}`, s.out.String())
+}
+
+func (s *TestTruncateSuite) TestLogRequestNotTruncated() {
+	TruncateStringLen = 3
+	LogRequest(s.log, &pb.TestMessage{Test: "PhilipWasHere"})
+	s.log.Msg("")
+	s.JSONEq(`{"level":"debug","req":{"test":"PhilipWasHere"}}`, s.out.String())
+}
+
+func (s *TestTruncateSuite) TestTruncateRepeated() {
+	TruncateRepeatedLen = 2
+	msg := &descriptorpb.DescriptorProto{
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: proto.String("a")},
+			{Name: proto.String("b")},
+			{Name: proto.String("c")},
+		},
+	}
+	truncated, fields := Truncate(msg)
+	s.Len(truncated.(*descriptorpb.DescriptorProto).Field, 2)
+	s.Len(msg.Field, 3, "Expected original message to be unchanged")
+	s.Equal(map[string]int{"field": 3}, fields)
+}
+
+func (s *TestTruncateSuite) TestTruncateDepth() {
+	TruncateDepth = 2
+	msg := &descriptorpb.DescriptorProto{
+		Name: proto.String("a"),
+		NestedType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("b"),
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("c"),
+			}},
+		}},
+	}
+	truncated, _ := Truncate(msg)
+	nested := truncated.(*descriptorpb.DescriptorProto).NestedType
+	s.Len(nested, 1)
+	s.Equal("b", nested[0].GetName())
+	s.Empty(nested[0].NestedType)
+}
+
+func (s *TestTruncateSuite) TestTruncateNested() {
+	TruncateStringLen = 1
+	msg := &descriptorpb.DescriptorProto{
+		Options: &descriptorpb.MessageOptions{
+			UninterpretedOption: []*descriptorpb.UninterpretedOption{{
+				IdentifierValue: proto.String("Philip"),
+				StringValue:     []byte("Philip"),
+			}},
+		},
+	}
+	TruncateBytesLen = 2
+	truncated, _ := Truncate(msg)
+	option := truncated.(*descriptorpb.DescriptorProto).Options.UninterpretedOption[0]
+	s.Equal("P", option.GetIdentifierValue())
+	s.Equal([]byte("Ph"), option.GetStringValue())
+}
@@ -18,18 +18,23 @@ func UnaryInterceptorWithLogger(log *zerolog.Logger) grpc.ServerOption {
 	return grpc.UnaryInterceptor(NewUnaryServerInterceptorWithLogger(log))
 }
 
-// NewUnaryServerInterceptor that logs gRPC Requests using Zerolog.
+// NewUnaryServerInterceptor that logs gRPC Requests using Zerolog. With StatsHandler, calls are logged with their
+// headers and trailers once they end.
 //	{
+//		PackageField: "example",
 //		ServiceField: "ExampleService",
 //		MethodField: "ExampleMethod",
+//		MethodTypeField: "unary",
 //		DurationField: 1.00
 //
 //		IpField: "127.0.0.1",
+//		PortField: "8000",
 //
 //		MetadataField: {},
 //
 //		UserAgentField: "ExampleClientUserAgent",
 //		ReqField: {}, // JSON representation of Request Protobuf
+//		ReqSizeField: 1024,
 //
 //		Err: "An unexpected error occurred",
 //		CodeField: "Unknown",
@@ -37,6 +42,7 @@ func UnaryInterceptorWithLogger(log *zerolog.Logger) grpc.ServerOption {
 //		DetailsField: [Errors],
 //
 //		RespField: {}, // JSON representation of Response Protobuf
+//		RespSizeField: 1024,
 //
 //		ZerologMessageField: "UnaryMessageDefault",
 //	}
@@ -48,18 +54,40 @@ func NewUnaryServerInterceptorWithLogger(log *zerolog.Logger) grpc.UnaryServerIn
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		now := time.Now()
 		resp, err := handler(ctx, req)
-		if log.Error().Enabled() {
+		policy := GetMethodPolicy(info.FullMethod)
+		call, deferred := ctx.Value(callStatsKey{}).(*callStats)
+		if !deferred {
+			call = &callStats{}
+		}
+		logCall := func(logger *zerolog.Event) {
+			LogIncomingCall(ctx, logger, info.FullMethod, now, req)
+			LogMethodType(logger, MethodTypeUnary)
 			if err != nil {
-				logger := log.Error()
-				LogIncomingCall(ctx, logger, info.FullMethod, now, req)
 				LogStatusError(logger, err)
-				logger.Msg(UnaryMessageDefault)
-			} else if log.Info().Enabled() {
-				logger := log.Info()
-				LogIncomingCall(ctx, logger, info.FullMethod, now, req)
-				LogResponse(logger, resp)
-				logger.Msg(UnaryMessageDefault)
+			} else {
+				if !policy.OmitResp {
+					LogResponse(logger, ProjectResponse(info.FullMethod, resp))
+				}
+				LogResponseSize(logger, resp)
+			}
+			call.log(logger)
+			logger.Msg(UnaryMessageDefault)
+		}
+		end := func() {
+			if policy.Logged(err) && log.Error().Enabled() {
+				if err != nil {
+					logCall(log.Error())
+				} else if log.Info().Enabled() {
+					logCall(log.Info())
+				}
 			}
+			PublishCall(ctx, info.FullMethod, now, err, logCall)
+		}
+		if deferred {
+			// Headers and trailers are sent after handlers return.
+			call.onEnd(end)
+		} else {
+			end()
 		}
 		return resp, err
 	}
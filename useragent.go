@@ -0,0 +1,41 @@
+package zerolog
+
+import (
+	"strings"
+)
+
+// UserAgent of a gRPC client, e.g. "example-app/1.0.0 grpc-go/1.13.0".
+type UserAgent struct {
+	// GrpcLang of the gRPC library, e.g. "go", "java-netty" or "python".
+	GrpcLang string
+	// GrpcVersion of the gRPC library.
+	GrpcVersion string
+	// AppName preceding the gRPC library.
+	AppName string
+	// AppVersion preceding the gRPC library.
+	AppVersion string
+}
+
+// ParseUserAgent of a gRPC client into its gRPC library and application. Comments in parentheses are ignored.
+func ParseUserAgent(ua string) UserAgent {
+	parsed := UserAgent{}
+	depth := 0
+	for _, token := range strings.Fields(ua) {
+		if depth += strings.Count(token, "(") - strings.Count(token, ")"); depth > 0 || strings.HasSuffix(token, ")") {
+			continue
+		}
+		i := strings.Index(token, "/")
+		if i <= 0 {
+			continue
+		}
+		name, version := token[:i], token[i+1:]
+		if strings.HasPrefix(name, "grpc-") {
+			if parsed.GrpcLang == "" {
+				parsed.GrpcLang, parsed.GrpcVersion = strings.TrimPrefix(name, "grpc-"), version
+			}
+		} else if parsed.AppName == "" && parsed.GrpcLang == "" {
+			parsed.AppName, parsed.AppVersion = name, version
+		}
+	}
+	return parsed
+}
@@ -0,0 +1,22 @@
+package zerolog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	for ua, expected := range map[string]UserAgent{
+		"grpc-go/1.13.0":                                   {GrpcLang: "go", GrpcVersion: "1.13.0"},
+		"philip/1.0.0 grpc-go/1.13.0":                      {GrpcLang: "go", GrpcVersion: "1.13.0", AppName: "philip", AppVersion: "1.0.0"},
+		"grpc-java-netty/1.30.0":                           {GrpcLang: "java-netty", GrpcVersion: "1.30.0"},
+		"grpc-python/1.30.0 grpc-c/11.0.0 (linux; chttp2)": {GrpcLang: "python", GrpcVersion: "1.30.0"},
+		"grpc-csharp/2.30 (.NET Core 4.6; CLR 4.0.30319.42000; netstandard2.0; x64)": {GrpcLang: "csharp", GrpcVersion: "2.30"},
+		"philip (was/here) grpc-node-js/1.2.0":                                       {GrpcLang: "node-js", GrpcVersion: "1.2.0"},
+		"test":                                                                       {},
+		"":                                                                           {},
+	} {
+		assert.Equal(t, expected, ParseUserAgent(ua), ua)
+	}
+}
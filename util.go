@@ -3,7 +3,9 @@ package zerolog
 import (
 	"bytes"
 	"context"
-	"path"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
 	"strings"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 var (
@@ -20,6 +23,10 @@ var (
 	Marshaller = &jsonpb.Marshaler{}
 	// TimestampLog call start.
 	TimestampLog = true
+	// PackageField key.
+	PackageField = "package"
+	// PackageLog gRPC Protobuf package name.
+	PackageLog = true
 	// ServiceField key.
 	ServiceField = "service"
 	// ServiceLog gRPC service name.
@@ -28,6 +35,10 @@ var (
 	MethodField = "method"
 	// MethodLog gRPC method name.
 	MethodLog = true
+	// MethodTypeField key.
+	MethodTypeField = "grpc.kind"
+	// MethodTypeLog gRPC method type of unary, client_stream, server_stream or bidi_stream.
+	MethodTypeLog = true
 	// DurationField key.
 	DurationField = "dur"
 	// DurationLog gRPC call duration.
@@ -36,14 +47,81 @@ var (
 	IPField = "ip"
 	// IPLog gRPC client IP.
 	IPLog = true
+	// PortField key.
+	PortField = "port"
+	// TrustedProxies whose X-Forwarded-For and X-Real-IP metadata is trusted for the client IP. See SetTrustedProxies.
+	TrustedProxies []*net.IPNet
+	// TLSField key.
+	TLSField = "tls"
+	// TLSLog gRPC client TLS connection and certificate identity.
+	TLSLog = true
+	// CompressionField key.
+	CompressionField = "grpc.encoding"
+	// AcceptEncodingField key.
+	AcceptEncodingField = "grpc.accept_encoding"
+	// CompressionLog gRPC request compressor and client accepted encodings.
+	CompressionLog = true
+	// AuthorityField key.
+	AuthorityField = "grpc.authority"
+	// AuthorityLog gRPC :authority of call.
+	AuthorityLog = true
+	// ContentSubtypeField key.
+	ContentSubtypeField = "grpc.content_subtype"
+	// ContentSubtypeLog gRPC codec content-subtype, e.g. "proto" or "json".
+	ContentSubtypeLog = true
 	// MetadataField key.
 	MetadataField = "md"
 	// MetadataLog gRPC call metadata.
 	MetadataLog = true
+	// MetadataAllowlist of metadata keys logged, or prefixes ending with "*". Empty logs every key.
+	MetadataAllowlist []string
+	// MetadataDenylist of metadata keys not logged, or prefixes ending with "*".
+	MetadataDenylist []string
+	// MetadataRedactlist of metadata keys logged as RedactedValue, or prefixes ending with "*".
+	MetadataRedactlist = []string{
+		"authorization",
+		"proxy-authorization",
+		"cookie",
+		"set-cookie",
+		"x-api-key",
+		"api-key",
+		"x-auth-token",
+		"x-csrf-token",
+		"x-amz-security-token",
+	}
+	// JWTLog claims of bearer tokens in authorization metadata, decoded without verification. Tokens are never logged.
+	JWTLog = false
+	// JWTClaims logged as top-level fields, mapping claim names to keys.
+	JWTClaims = map[string]string{
+		"sub":       "sub",
+		"iss":       "iss",
+		"aud":       "aud",
+		"client_id": "client_id",
+		"scope":     "scope",
+		"scp":       "scp",
+	}
+	// HeaderField key.
+	HeaderField = "header"
+	// HeaderLog gRPC response headers set by handlers. Headers and trailers of unary calls need StatsHandler.
+	HeaderLog = true
+	// TrailerField key.
+	TrailerField = "trailer"
+	// TrailerLog gRPC response trailers set by handlers.
+	TrailerLog = true
+	// RedactedValue of redacted metadata.
+	RedactedValue = "[REDACTED]"
 	// UserAgentField key.
 	UserAgentField = "ua"
-	// UserAgentLog gRPC client User Agent.
+	// UserAgentLog gRPC client User Agent, independent of MetadataLog.
 	UserAgentLog = true
+	// GrpcLangField key of gRPC client library language, e.g. "go" or "java-netty".
+	GrpcLangField = "grpc_lang"
+	// GrpcVersionField key of gRPC client library version.
+	GrpcVersionField = "grpc_version"
+	// AppNameField key of gRPC client application, preceding the gRPC library in User Agent.
+	AppNameField = "app_name"
+	// AppVersionField key of gRPC client application version.
+	AppVersionField = "app_version"
 	// ReqField key.
 	ReqField = "req"
 	// ReqLog gRPC request body.
@@ -52,8 +130,32 @@ var (
 	RespField = "resp"
 	// RespLog gRPC response body.
 	RespLog = true
+	// ReqSizeField key.
+	ReqSizeField = "req_size"
+	// RespSizeField key.
+	RespSizeField = "resp_size"
+	// SizeLog gRPC body wire size, even if body logging is disabled.
+	SizeLog = true
+	// ReqDigestField key.
+	ReqDigestField = "req_sha256"
+	// RespDigestField key.
+	RespDigestField = "resp_sha256"
+	// DigestLog gRPC body SHA-256 digest, even if body logging is disabled.
+	DigestLog = false
 	// MaxSize to log gRPC bodies.
 	MaxSize = 2048000
+	// TruncateLog gRPC bodies of at least MaxSize, instead of dropping them.
+	TruncateLog = true
+	// TruncatedSuffix of the field marking a truncated gRPC body, e.g. "req_truncated".
+	TruncatedSuffix = "_truncated"
+	// TruncateStringLen maximum runes of string fields in truncated bodies.
+	TruncateStringLen = 1024
+	// TruncateBytesLen maximum bytes of bytes fields in truncated bodies.
+	TruncateBytesLen = 256
+	// TruncateRepeatedLen maximum elements of repeated and map fields in truncated bodies.
+	TruncateRepeatedLen = 16
+	// TruncateDepth maximum nesting of messages in truncated bodies.
+	TruncateDepth = 8
 	// CodeField gRPC status code response.
 	CodeField = "code"
 	// MsgField gRPC response message.
@@ -62,20 +164,31 @@ var (
 	DetailsField = "details"
 	// UnaryMessageDefault of logging messages from unary.
 	UnaryMessageDefault = "unary"
+	// StreamMessageDefault of logging messages from stream.
+	StreamMessageDefault = "stream"
 )
 
 // LogIncomingCall of gRPC method.
 //	{
+//		PackageField: example,
 //		ServiceField: ExampleService,
 //		MethodField: ExampleMethod,
 //		DurationField: 1.00,
 //	}
 func LogIncomingCall(ctx context.Context, logger *zerolog.Event, method string, t time.Time, req interface{}) {
 	LogTimestamp(logger, t)
+	LogPackage(logger, method)
 	LogService(logger, method)
 	LogMethod(logger, method)
 	LogDuration(logger, t)
-	LogRequest(logger, req)
+	LogIP(ctx, logger)
+	LogTLS(ctx, logger)
+	LogTransport(ctx, logger)
+	if !GetMethodPolicy(method).OmitReq {
+		LogRequest(logger, ProjectRequest(method, req))
+	}
+	LogRequestSize(logger, req)
+	LogPromotedFields(logger, method, req)
 	LogIncomingMetadata(ctx, logger)
 }
 
@@ -89,13 +202,23 @@ func LogTimestamp(logger *zerolog.Event, t time.Time) {
 	}
 }
 
-// LogService of gRPC name.
+// LogPackage of gRPC service, if assigned.
+//	{
+//		PackageField: gRPCPackageName,
+//	}
+func LogPackage(logger *zerolog.Event, method string) {
+	if pkg := ParseFullMethod(method).Package; PackageLog && pkg != "" {
+		*logger = *logger.Str(PackageField, pkg)
+	}
+}
+
+// LogService of gRPC name, without its package.
 //	{
 //		ServiceField: gRPCServiceName,
 //	}
 func LogService(logger *zerolog.Event, method string) {
-	if ServiceLog {
-		*logger = *logger.Str(ServiceField, path.Dir(method)[1:])
+	if service := ParseFullMethod(method).Service; ServiceLog && service != "" {
+		*logger = *logger.Str(ServiceField, service)
 	}
 }
 
@@ -105,7 +228,17 @@ func LogService(logger *zerolog.Event, method string) {
 //	}
 func LogMethod(logger *zerolog.Event, method string) {
 	if MethodLog {
-		*logger = *logger.Str(MethodField, path.Base(method))
+		*logger = *logger.Str(MethodField, ParseFullMethod(method).Method)
+	}
+}
+
+// LogMethodType of gRPC call.
+//	{
+//		MethodTypeField: "unary",
+//	}
+func LogMethodType(logger *zerolog.Event, t MethodType) {
+	if MethodTypeLog {
+		*logger = *logger.Str(MethodTypeField, string(t))
 	}
 }
 
@@ -119,38 +252,91 @@ func LogDuration(logger *zerolog.Event, t time.Time) {
 	}
 }
 
-// LogIP address of gRPC client, if assigned.
+// LogIP address and port of gRPC client, if assigned. Behind TrustedProxies, the client IP is taken from
+// X-Forwarded-For or X-Real-IP metadata.
 //	{
-//		IpField: 127.0.0.1
+//		IPField: "127.0.0.1",
+//		PortField: "8000",
 //	}
 func LogIP(ctx context.Context, logger *zerolog.Event) {
 	if IPLog {
-		if p, ok := peer.FromContext(ctx); ok {
-			*logger = *logger.Str(IPField, p.Addr.String())
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			ip, port := SplitHostPort(p.Addr.String())
+			if client := GetClientIP(ctx, ip); client != ip {
+				ip, port = client, ""
+			}
+			*logger = *logger.Str(IPField, ip)
+			if port != "" {
+				*logger = *logger.Str(PortField, port)
+			}
 		}
 	}
 }
 
-// LogRequest in JSON of gRPC Call, given Request is smaller than MaxSize (Default=2MB).
+// LogRequest in JSON of gRPC Call, given Request is smaller than MaxSize (Default=2MB), or truncated if TruncateLog.
+// Requests generated by protoc-gen-go-zerolog are logged with their own size limits.
 //	{
 //		ReqField: {}
 //	}
 func LogRequest(e *zerolog.Event, req interface{}) {
 	if ReqLog {
-		if b := GetRawJSON(req); b != nil {
-			*e = *e.RawJSON(ReqField, b.Bytes())
-		}
+		logBody(e, ReqField, req)
 	}
 }
 
-// LogResponse in JSON of gRPC Call, given Response is smaller than MaxSize (Default=2MB).
+// LogResponse in JSON of gRPC Call, given Response is smaller than MaxSize (Default=2MB), or truncated if TruncateLog.
+// Responses generated by protoc-gen-go-zerolog are logged with their own size limits.
 //	{
 //		RespField: {}
 //	}
 func LogResponse(e *zerolog.Event, resp interface{}) {
 	if RespLog {
-		if b := GetRawJSON(resp); b != nil {
-			*e = *e.RawJSON(RespField, b.Bytes())
+		logBody(e, RespField, resp)
+	}
+}
+
+// LogRequestSize in bytes and SHA-256 digest of gRPC Request.
+//	{
+//		ReqSizeField: 1024,
+//		ReqDigestField: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+//	}
+func LogRequestSize(e *zerolog.Event, req interface{}) {
+	logSize(e, ReqSizeField, ReqDigestField, req)
+}
+
+// LogResponseSize in bytes and SHA-256 digest of gRPC Response.
+//	{
+//		RespSizeField: 1024,
+//		RespDigestField: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+//	}
+func LogResponseSize(e *zerolog.Event, resp interface{}) {
+	logSize(e, RespSizeField, RespDigestField, resp)
+}
+
+func logSize(e *zerolog.Event, sizeField, digestField string, i interface{}) {
+	pb, ok := i.(proto.Message)
+	if !ok {
+		return
+	}
+	if SizeLog {
+		*e = *e.Int(sizeField, proto.Size(pb))
+	}
+	if DigestLog {
+		if b, err := (protov2.MarshalOptions{Deterministic: true}).Marshal(proto.MessageV2(pb)); err == nil {
+			sum := sha256.Sum256(b)
+			*e = *e.Str(digestField, hex.EncodeToString(sum[:]))
+		}
+	}
+}
+
+func logBody(e *zerolog.Event, field string, i interface{}) {
+	if m, ok := i.(zerolog.LogObjectMarshaler); ok {
+		*e = *e.Object(field, m)
+	} else if pb, ok := i.(proto.Message); ok {
+		if b := marshalJSON(pb); b != nil && b.Len() < MaxSize {
+			*e = *e.RawJSON(field, b.Bytes())
+		} else if b != nil && TruncateLog {
+			LogTruncated(e, field, pb, b.Len())
 		}
 	}
 }
@@ -158,15 +344,22 @@ func LogResponse(e *zerolog.Event, resp interface{}) {
 // GetRawJSON converts a Protobuf message to JSON bytes if less than MaxSize.
 func GetRawJSON(i interface{}) *bytes.Buffer {
 	if pb, ok := i.(proto.Message); ok {
-		b := &bytes.Buffer{}
-		if err := Marshaller.Marshal(b, pb); err == nil && b.Len() < MaxSize {
+		if b := marshalJSON(pb); b != nil && b.Len() < MaxSize {
 			return b
 		}
 	}
 	return nil
 }
 
-// LogIncomingMetadata or UserAgent field of incoming gRPC Request, if assigned.
+func marshalJSON(pb proto.Message) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	if err := Marshaller.Marshal(b, pb); err != nil {
+		return nil
+	}
+	return b
+}
+
+// LogIncomingMetadata, UserAgent fields and JWTClaims of incoming gRPC Request, if assigned.
 //	{
 //		MetadataField: {
 //			MetadataKey1: MetadataValue1,
@@ -180,34 +373,64 @@ func LogIncomingMetadata(ctx context.Context, e *zerolog.Event) {
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if MetadataLog {
 			*e = *e.Dict(MetadataField, LogMetadata(&md))
-			return
-		} else if UserAgentLog {
+		}
+		if UserAgentLog {
 			LogUserAgent(e, &md)
 		}
+		LogJWTClaims(e, &md)
 	}
 }
 
-// LogMetadata of gRPC Request
+// LogMetadata of gRPC Request. Multiple values are logged as arrays, and binary (-bin) values in base64,
+// or JSON if registered with RegisterBinaryMetadata.
 //	{
 //		MetadataField: {
 //			MetadataKey1: MetadataValue1,
+//			MetadataKey2: [MetadataValue2, MetadataValue3],
 //		}
 //	}
 func LogMetadata(md *metadata.MD) *zerolog.Event {
 	dict := zerolog.Dict()
-	for i := range *md {
-		dict = dict.Str(i, strings.Join(md.Get(i), ","))
+	for i, values := range *md {
+		if !IsMetadataLogged(i) || len(values) == 0 {
+			continue
+		} else if IsMetadataRedacted(i) {
+			dict = dict.Str(i, RedactedValue)
+		} else if len(values) == 1 {
+			logMetadataValue(dict, i, values[0])
+		} else {
+			arr := zerolog.Arr()
+			for _, v := range values {
+				logMetadataArrayValue(arr, i, v)
+			}
+			dict = dict.Array(i, arr)
+		}
 	}
 	return dict
 }
 
-// LogUserAgent of gRPC Client, if assigned.
+// LogUserAgent of gRPC Client, if assigned, with its gRPC library and application.
 //	{
 //		UserAgentField: "Client-assigned User-Agent",
+//		GrpcLangField: "go",
+//		GrpcVersionField: "1.13.0",
+//		AppNameField: "example-app",
+//		AppVersionField: "1.0.0",
 //	}
 func LogUserAgent(logger *zerolog.Event, md *metadata.MD) {
 	if ua := strings.Join(md.Get("user-agent"), ""); ua != "" {
 		*logger = *logger.Str(UserAgentField, ua)
+		parsed := ParseUserAgent(ua)
+		for _, field := range [][2]string{
+			{GrpcLangField, parsed.GrpcLang},
+			{GrpcVersionField, parsed.GrpcVersion},
+			{AppNameField, parsed.AppName},
+			{AppVersionField, parsed.AppVersion},
+		} {
+			if field[1] != "" {
+				*logger = *logger.Str(field[0], field[1])
+			}
+		}
 	}
 }
 
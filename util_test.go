@@ -52,27 +52,68 @@ func (s *TestUtilSuite) SetupTest() {
 	s.log = logger.Debug()
 
 	TimestampLog = true
+	PackageField = "package"
+	PackageLog = true
 	ServiceField = "service"
 	ServiceLog = true
 	MethodField = "method"
 	MethodLog = true
+	MethodTypeField = "grpc.kind"
+	MethodTypeLog = true
 	DurationField = "dur"
 	DurationLog = true
 	IPField = "ip"
 	IPLog = true
+	PortField = "port"
+	TrustedProxies = nil
+	TLSField = "tls"
+	TLSLog = true
+	CompressionField = "grpc.encoding"
+	AcceptEncodingField = "grpc.accept_encoding"
+	CompressionLog = true
+	AuthorityField = "grpc.authority"
+	AuthorityLog = true
+	ContentSubtypeField = "grpc.content_subtype"
+	ContentSubtypeLog = true
 	MetadataField = "md"
 	MetadataLog = true
+	MetadataAllowlist = nil
+	MetadataDenylist = nil
+	MetadataRedactlist = []string{"authorization"}
+	JWTLog = false
+	HeaderField = "header"
+	HeaderLog = true
+	TrailerField = "trailer"
+	TrailerLog = true
+	RedactedValue = "[REDACTED]"
 	UserAgentField = "ua"
 	UserAgentLog = true
+	GrpcLangField = "grpc_lang"
+	GrpcVersionField = "grpc_version"
+	AppNameField = "app_name"
+	AppVersionField = "app_version"
 	ReqField = "req"
 	ReqLog = true
 	RespField = "resp"
 	RespLog = true
+	ReqSizeField = "req_size"
+	RespSizeField = "resp_size"
+	SizeLog = true
+	ReqDigestField = "req_sha256"
+	RespDigestField = "resp_sha256"
+	DigestLog = false
 	MaxSize = 2048000
+	TruncateLog = true
+	TruncatedSuffix = "_truncated"
+	TruncateStringLen = 1024
+	TruncateBytesLen = 256
+	TruncateRepeatedLen = 16
+	TruncateDepth = 8
 	CodeField = "code"
 	MsgField = "msg"
 	DetailsField = "details"
 	UnaryMessageDefault = "unary"
+	StreamMessageDefault = "stream"
 }
 
 func TestUtil(t *testing.T) {
@@ -140,6 +181,34 @@ func (s *TestUtilSuite) TestLogResponseField() {
 	s.JSONEq(`{"level":"debug","philip":{"test":"resp"},"message":"PhilipB"}`, s.out.String())
 }
 
+func (s *TestUtilSuite) TestLogRequestSize() {
+	LogRequestSize(s.log, s.req)
+	s.log.Msg(s.msg)
+	s.JSONEq(`{"level":"debug","req_size":5,"message":"PhilipB"}`, s.out.String())
+}
+
+func (s *TestUtilSuite) TestLogRequestSizeDigest() {
+	ReqLog = false
+	DigestLog = true
+	LogRequest(s.log, s.req)
+	LogRequestSize(s.log, s.req)
+	s.log.Msg(s.msg)
+	s.JSONEq(`{"level":"debug","req_size":5,"req_sha256":"0f63ec8cc9640bee5547dbb87a053724a90706748fa04c7ee45cf99167cb5850","message":"PhilipB"}`, s.out.String())
+}
+
+func (s *TestUtilSuite) TestLogResponseSizeDisabled() {
+	SizeLog = false
+	LogResponseSize(s.log, s.resp)
+	s.log.Msg(s.msg)
+	s.JSONEq(s.msgDef, s.out.String())
+}
+
+func (s *TestUtilSuite) TestLogResponseSizeInvalid() {
+	LogResponseSize(s.log, new(interface{}))
+	s.log.Msg(s.msg)
+	s.JSONEq(s.msgDef, s.out.String())
+}
+
 func (s *TestUtilSuite) TestGetRawJSONInvalid() {
 	s.Nil(GetRawJSON(new(interface{})))
 }
@@ -192,3 +261,21 @@ func (s *TestUtilSuite) TestLogIncomingMetadataDisabledForIpInvalid() {
 	s.log.Msg(s.msg)
 	s.JSONEq(s.msgDef, s.out.String())
 }
+
+func (s *TestUtilSuite) TestLogIncomingMetadataUserAgent() {
+	LogIncomingMetadata(metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"user-agent": "philip/1.0.0 grpc-go/1.13.0",
+	})), s.log)
+	s.log.Msg(s.msg)
+	s.JSONEq(`{"message":"PhilipB","level":"debug","md":{"user-agent":"philip/1.0.0 grpc-go/1.13.0"},"ua":"philip/1.0.0 grpc-go/1.13.0",`+
+		`"grpc_lang":"go","grpc_version":"1.13.0","app_name":"philip","app_version":"1.0.0"}`, s.out.String())
+}
+
+func (s *TestUtilSuite) TestLogIncomingMetadataUserAgentDisabled() {
+	UserAgentLog = false
+	LogIncomingMetadata(metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"user-agent": "grpc-go/1.13.0",
+	})), s.log)
+	s.log.Msg(s.msg)
+	s.JSONEq(`{"message":"PhilipB","level":"debug","md":{"user-agent":"grpc-go/1.13.0"}}`, s.out.String())
+}
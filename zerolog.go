@@ -2,6 +2,9 @@ package zerolog
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/grpclog"
@@ -17,24 +20,82 @@ func GrpcLogSetZeroLogger(logger GrpcZeroLogger) {
 	grpclog.SetLoggerV2(logger)
 }
 
-// GrpcZeroLogger transforms grpc log calls to Zerolog logger.
+const (
+	// grpclogDepth of frames from GrpcZeroLogger depth methods to the caller of grpclog depth functions.
+	grpclogDepth = 2
+	// noCaller logged by GrpcZeroLogger.output.
+	noCaller = -1
+)
+
+// GrpcZeroLogger transforms grpc log calls to Zerolog logger. It implements grpclog.DepthLoggerV2.
 type GrpcZeroLogger struct {
-	log zerolog.Logger
+	log        zerolog.Logger
+	verbosity  int
+	components map[string]zerolog.Level
+	fatal      func(msg string)
+	limiter    *grpcRateLimiter
 }
 
-// NewGrpcZeroLogger creates a new GrpcZeroLogger
+// NewGrpcZeroLogger creates a new GrpcZeroLogger, with verbosity of GRPC_GO_LOG_VERBOSITY_LEVEL and minimum level of
+// GRPC_GO_LOG_SEVERITY_LEVEL (info, warning or error), if assigned.
 func NewGrpcZeroLogger(logger zerolog.Logger) GrpcZeroLogger {
-	return GrpcZeroLogger{log: logger}
+	l := GrpcZeroLogger{log: logger}
+	if v, err := strconv.Atoi(os.Getenv("GRPC_GO_LOG_VERBOSITY_LEVEL")); err == nil {
+		l = l.WithVerbosity(v)
+	}
+	switch strings.ToLower(os.Getenv("GRPC_GO_LOG_SEVERITY_LEVEL")) {
+	case "info":
+		l = l.WithSeverity(zerolog.InfoLevel)
+	case "warning":
+		l = l.WithSeverity(zerolog.WarnLevel)
+	case "error":
+		l = l.WithSeverity(zerolog.ErrorLevel)
+	}
+	return l
+}
+
+// WithVerbosity returns a copy of GrpcZeroLogger, enabling gRPC verbose logs up to level.
+func (l GrpcZeroLogger) WithVerbosity(level int) GrpcZeroLogger {
+	l.verbosity = level
+	return l
+}
+
+// WithSeverity returns a copy of GrpcZeroLogger, logging at least level, unless its logger level is higher.
+func (l GrpcZeroLogger) WithSeverity(level zerolog.Level) GrpcZeroLogger {
+	if level > l.log.GetLevel() {
+		l.log = l.log.Level(level)
+	}
+	return l
+}
+
+// WithComponentLevel returns a copy of GrpcZeroLogger, logging messages of a gRPC component (e.g. "transport") of at
+// least level.
+func (l GrpcZeroLogger) WithComponentLevel(component string, level zerolog.Level) GrpcZeroLogger {
+	components := make(map[string]zerolog.Level, len(l.components)+1)
+	for c, lvl := range l.components {
+		components[c] = lvl
+	}
+	components[component] = level
+	l.components = components
+	return l
+}
+
+// WithFatalHandler returns a copy of GrpcZeroLogger, logging fatal logs and calling handler with its message instead of
+// exiting, e.g. to flush buffers and gracefully stop servers. The grpclog package still exits after the handler returns,
+// so exiting is only avoided by calling the GrpcZeroLogger directly. A nil handler exits.
+func (l GrpcZeroLogger) WithFatalHandler(handler func(msg string)) GrpcZeroLogger {
+	l.fatal = handler
+	return l
 }
 
 // Fatal fatals arguments.
 func (l GrpcZeroLogger) Fatal(args ...interface{}) {
-	l.log.Fatal().Msg(fmt.Sprint(args...))
+	l.output(zerolog.FatalLevel, noCaller, fmt.Sprint(args...))
 }
 
 // Fatalf fatals formatted string with arguments.
 func (l GrpcZeroLogger) Fatalf(format string, args ...interface{}) {
-	l.log.Fatal().Msgf(format, args...)
+	l.output(zerolog.FatalLevel, noCaller, fmt.Sprintf(format, args...))
 }
 
 // Fatalln fatals and new line.
@@ -44,12 +105,12 @@ func (l GrpcZeroLogger) Fatalln(args ...interface{}) {
 
 // Error errors arguments.
 func (l GrpcZeroLogger) Error(args ...interface{}) {
-	l.log.Error().Msg(fmt.Sprint(args...))
+	l.output(zerolog.ErrorLevel, noCaller, fmt.Sprint(args...))
 }
 
 // Errorf errors formatted string with arguments.
 func (l GrpcZeroLogger) Errorf(format string, args ...interface{}) {
-	l.log.Error().Msgf(format, args...)
+	l.output(zerolog.ErrorLevel, noCaller, fmt.Sprintf(format, args...))
 }
 
 // Errorln errors and new line.
@@ -59,12 +120,12 @@ func (l GrpcZeroLogger) Errorln(args ...interface{}) {
 
 // Info infos arguments.
 func (l GrpcZeroLogger) Info(args ...interface{}) {
-	l.log.Info().Msg(fmt.Sprint(args...))
+	l.output(zerolog.InfoLevel, noCaller, fmt.Sprint(args...))
 }
 
 // Infof infos formatted string with arguments.
 func (l GrpcZeroLogger) Infof(format string, args ...interface{}) {
-	l.log.Info().Msgf(format, args...)
+	l.output(zerolog.InfoLevel, noCaller, fmt.Sprintf(format, args...))
 }
 
 // Infoln infos and new line.
@@ -74,12 +135,12 @@ func (l GrpcZeroLogger) Infoln(args ...interface{}) {
 
 // Warning warns arguments.
 func (l GrpcZeroLogger) Warning(args ...interface{}) {
-	l.log.Warn().Msg(fmt.Sprint(args...))
+	l.output(zerolog.WarnLevel, noCaller, fmt.Sprint(args...))
 }
 
 // Warningf warns formatted string with arguments.
 func (l GrpcZeroLogger) Warningf(format string, args ...interface{}) {
-	l.log.Warn().Msgf(format, args...)
+	l.output(zerolog.WarnLevel, noCaller, fmt.Sprintf(format, args...))
 }
 
 // Warningln warns and new line.
@@ -102,18 +163,70 @@ func (l GrpcZeroLogger) Println(args ...interface{}) {
 	l.Infoln(args...)
 }
 
-// V determines Verbosity Level.
-func (l GrpcZeroLogger) V(level int) bool {
-	switch level {
-	case 0:
-		return zerolog.InfoLevel <= zerolog.GlobalLevel()
-	case 1:
-		return zerolog.WarnLevel <= zerolog.GlobalLevel()
-	case 2:
-		return zerolog.ErrorLevel <= zerolog.GlobalLevel()
-	case 3:
-		return zerolog.FatalLevel <= zerolog.GlobalLevel()
-	default:
-		panic("unhandled gRPC logger level")
+// InfoDepth infos arguments, with the caller depth frames above the caller of grpclog.InfoDepth.
+func (l GrpcZeroLogger) InfoDepth(depth int, args ...interface{}) {
+	l.output(zerolog.InfoLevel, depth+grpclogDepth+1, fmt.Sprint(args...))
+}
+
+// WarningDepth warns arguments, with the caller depth frames above the caller of grpclog.WarningDepth.
+func (l GrpcZeroLogger) WarningDepth(depth int, args ...interface{}) {
+	l.output(zerolog.WarnLevel, depth+grpclogDepth+1, fmt.Sprint(args...))
+}
+
+// ErrorDepth errors arguments, with the caller depth frames above the caller of grpclog.ErrorDepth.
+func (l GrpcZeroLogger) ErrorDepth(depth int, args ...interface{}) {
+	l.output(zerolog.ErrorLevel, depth+grpclogDepth+1, fmt.Sprint(args...))
+}
+
+// FatalDepth fatals arguments, with the caller depth frames above the caller of grpclog.FatalDepth.
+func (l GrpcZeroLogger) FatalDepth(depth int, args ...interface{}) {
+	l.output(zerolog.FatalLevel, depth+grpclogDepth+1, fmt.Sprint(args...))
+}
+
+// output a gRPC log message with its parsed fields, unless filtered by its component level. Caller is the number of
+// frames above output to log as the caller, or noCaller. Fatal logs are never filtered, and flush rate limited logs.
+func (l GrpcZeroLogger) output(level zerolog.Level, caller int, msg string) {
+	parsed := ParseGrpcLog(msg)
+	if min, ok := l.components[parsed.Component]; ok && level < min && level < zerolog.FatalLevel {
+		return
+	}
+	repeated := 0
+	if level == zerolog.FatalLevel {
+		l.Flush()
+	} else if l.limiter != nil && l.enabled(level) {
+		var ok bool
+		if repeated, ok = l.limiter.allow(level, parsed, l.repeated); !ok {
+			return
+		}
 	}
+	var e *zerolog.Event
+	if level == zerolog.FatalLevel && l.fatal == nil {
+		e = l.log.Fatal()
+	} else {
+		e = l.log.WithLevel(level)
+	}
+	// Events are nil when their level is disabled, or dropped by a sampler.
+	if e != nil {
+		if caller != noCaller {
+			e = e.Caller(caller)
+		}
+		LogGrpcLog(e, parsed)
+		if repeated > 0 {
+			LogRepeated(e, repeated, l.limiter.window)
+		}
+		e.Msg(parsed.Message)
+	}
+	if level == zerolog.FatalLevel && l.fatal != nil {
+		l.fatal(msg)
+	}
+}
+
+// enabled reports whether logs of level are enabled by the logger and global levels.
+func (l GrpcZeroLogger) enabled(level zerolog.Level) bool {
+	return level >= l.log.GetLevel() && level >= zerolog.GlobalLevel()
+}
+
+// V reports whether gRPC verbose logs of level are enabled, given the verbosity and info logs are enabled.
+func (l GrpcZeroLogger) V(level int) bool {
+	return level <= l.verbosity && l.log.GetLevel() <= zerolog.InfoLevel && zerolog.GlobalLevel() <= zerolog.InfoLevel
 }
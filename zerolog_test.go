@@ -2,6 +2,9 @@ package zerolog
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"runtime"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -88,6 +91,37 @@ func (s *TestLogSuite) TestWarningln() {
 	s.JSONEq(`{"level":"warn","message":"WasHere"}`, s.out.String())
 }
 
+var _ grpclog.DepthLoggerV2 = GrpcZeroLogger{}
+
+// grpclogDepthCall calls a DepthLoggerV2 method like grpclog depth functions.
+func grpclogDepthCall(log func(int, ...interface{}), depth int, args ...interface{}) {
+	log(depth, args...)
+}
+
+func (s *TestLogSuite) TestDepth() {
+	var logger grpclog.DepthLoggerV2 = s.grpclog
+	_, file, line, _ := runtime.Caller(0)
+	grpclogDepthCall(logger.InfoDepth, 0, s.args...)
+	s.JSONEq(fmt.Sprintf(`{"level":"info","caller":"%v:%v","message":"WasHere"}`, file, line+1), s.out.String())
+}
+
+func (s *TestLogSuite) TestWarningDepth() {
+	_, file, line, _ := runtime.Caller(0)
+	s.depth(s.grpclog.WarningDepth)
+	s.JSONEq(fmt.Sprintf(`{"level":"warn","caller":"%v:%v","message":"WasHere"}`, file, line+1), s.out.String())
+}
+
+func (s *TestLogSuite) TestErrorDepth() {
+	_, file, line, _ := runtime.Caller(0)
+	s.depth(s.grpclog.ErrorDepth)
+	s.JSONEq(fmt.Sprintf(`{"level":"error","caller":"%v:%v","message":"WasHere"}`, file, line+1), s.out.String())
+}
+
+// depth logs at depth 1, attributing logs to the caller of depth.
+func (s *TestLogSuite) depth(log func(int, ...interface{})) {
+	grpclogDepthCall(log, 1, s.args...)
+}
+
 // Note: gRPC Logger v2 deprecated Print methods to instead use Info methods.
 func (s *TestLogSuite) TestPrint() {
 	s.grpclog.Print(s.args...)
@@ -105,29 +139,104 @@ func (s *TestLogSuite) TestPrintln() {
 }
 
 func (s *TestLogSuite) TestV() {
-	zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	s.CheckV(0) // Check gRPC Info Logs
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	s.True(s.grpclog.V(0), "Expected verbosity 0 enabled by default")
+	s.False(s.grpclog.V(1))
+
+	s.grpclog = s.grpclog.WithVerbosity(2)
+	s.True(s.grpclog.V(2))
+	s.False(s.grpclog.V(3))
+	s.False(s.grpclog.V(99), "Expected no panic for gRPC verbosity levels")
 
 	zerolog.SetGlobalLevel(zerolog.WarnLevel)
-	s.CheckV(1) // Check gRPC Warn Logs
+	s.False(s.grpclog.V(0), "Expected verbose logs disabled by global level")
 
-	zerolog.SetGlobalLevel(zerolog.ErrorLevel)
-	s.CheckV(2) // Check gRPC Error Logs
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	s.grpclog = NewGrpcZeroLogger(s.logger.Level(zerolog.ErrorLevel))
+	s.False(s.grpclog.V(0), "Expected verbose logs disabled by logger level")
+}
 
-	zerolog.SetGlobalLevel(zerolog.FatalLevel)
-	s.CheckV(3) // Check gRPC Fatal Logs
+func (s *TestLogSuite) TestNewGrpcZeroLoggerEnv() {
+	defer os.Unsetenv("GRPC_GO_LOG_VERBOSITY_LEVEL")
+	defer os.Unsetenv("GRPC_GO_LOG_SEVERITY_LEVEL")
+	s.NoError(os.Setenv("GRPC_GO_LOG_VERBOSITY_LEVEL", "2"))
+	s.NoError(os.Setenv("GRPC_GO_LOG_SEVERITY_LEVEL", "WARNING"))
 
-	s.PanicsWithValue("unhandled gRPC logger level", func() {
-		s.grpclog.V(4)
+	s.grpclog = NewGrpcZeroLogger(s.logger)
+	s.False(s.grpclog.V(2), "Expected verbose logs disabled by severity")
+	s.grpclog.Info(s.args...)
+	s.grpclog.Warning(s.args...)
+	s.JSONEq(`{"level":"warn","message":"WasHere"}`, s.out.String())
+
+	s.NoError(os.Setenv("GRPC_GO_LOG_SEVERITY_LEVEL", "info"))
+	s.grpclog = NewGrpcZeroLogger(s.logger)
+	s.True(s.grpclog.V(2))
+	s.False(s.grpclog.V(3))
+
+	s.NoError(os.Setenv("GRPC_GO_LOG_SEVERITY_LEVEL", "error"))
+	s.grpclog = NewGrpcZeroLogger(s.logger.Level(zerolog.FatalLevel))
+	s.Equal(zerolog.FatalLevel, s.grpclog.log.GetLevel(), "Expected logger level to be kept")
+}
+
+func (s *TestLogSuite) TestGrpcLogFields() {
+	s.grpclog.Info("[core] [Channel #3 SubChannel #4] Subchannel Connectivity change to READY")
+	s.JSONEq(`{"level":"info","component":"core","channel_id":3,"subchannel_id":4,"state":"READY",`+
+		`"message":"Subchannel Connectivity change to READY"}`, s.out.String())
+}
+
+func (s *TestLogSuite) TestGrpcLogFieldsDisabled() {
+	s.NotPanics(func() {
+		NewGrpcZeroLogger(s.logger.Level(zerolog.WarnLevel)).Info("[core] [Channel #1] Channel Connectivity change to READY")
+		s.grpclog.WithSeverity(zerolog.WarnLevel).Info("[core] [Channel #1] Channel Connectivity change to READY")
 	})
+	s.Empty(s.out.String(), "Expected disabled logs not logged")
 }
 
-func (s *TestLogSuite) CheckV(l int) {
-	for i := 0; i <= l; i++ {
-		s.Truef(s.grpclog.V(i), "level %v not enabled for %v", i, zerolog.GlobalLevel().String())
-	}
+func (s *TestLogSuite) TestWithComponentLevel() {
+	logger := s.grpclog.WithComponentLevel("transport", zerolog.WarnLevel)
+	logger.Infof("[transport] [server-transport %p] Closing: %v", s, "EOF")
+	s.Empty(s.out.String(), "Expected transport info logs filtered")
+	logger.Warning("[transport] http2Server.HandleStreams failed")
+	s.JSONEq(`{"level":"warn","component":"transport","message":"http2Server.HandleStreams failed"}`, s.out.String())
+
+	s.out.Reset()
+	logger.Info("[core] Channel Connectivity change to IDLE")
+	s.JSONEq(`{"level":"info","component":"core","state":"IDLE","message":"Channel Connectivity change to IDLE"}`,
+		s.out.String())
+
+	s.out.Reset()
+	s.grpclog.Info("[transport] Closing")
+	s.NotEmpty(s.out.String(), "Expected original GrpcZeroLogger unchanged")
+}
 
-	for i := l + 1; i < 4; i++ {
-		s.Falsef(s.grpclog.V(i), "level %v enabled for %v", i, zerolog.GlobalLevel().String())
-	}
+func (s *TestLogSuite) TestWithFatalHandler() {
+	var msgs []string
+	logger := s.grpclog.WithFatalHandler(func(msg string) {
+		msgs = append(msgs, msg)
+	})
+	logger.Fatalf(s.format, s.args...)
+	s.JSONEq(`{"level":"fatal","message":"PhilipWasHere"}`, s.out.String())
+	s.Equal([]string{"PhilipWasHere"}, msgs)
+
+	s.out.Reset()
+	logger = logger.WithComponentLevel("core", zerolog.Disabled)
+	logger.Fatalln("[core] grpc: failed")
+	s.JSONEq(`{"level":"fatal","component":"core","message":"grpc: failed"}`, s.out.String(),
+		"Expected fatal logs not filtered")
+	s.Equal([]string{"PhilipWasHere", "[core] grpc: failed"}, msgs)
+
+	s.out.Reset()
+	_, file, line, _ := runtime.Caller(0)
+	s.depth(logger.FatalDepth)
+	s.JSONEq(fmt.Sprintf(`{"level":"fatal","caller":"%v:%v","message":"WasHere"}`, file, line+1), s.out.String())
+}
+
+func (s *TestLogSuite) TestWithFatalHandlerPanic() {
+	logger := s.grpclog.WithFatalHandler(func(msg string) {
+		panic(msg)
+	})
+	s.PanicsWithValue("WasHere", func() {
+		logger.Fatal(s.args...)
+	})
+	s.JSONEq(`{"level":"fatal","message":"WasHere"}`, s.out.String())
 }